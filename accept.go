@@ -0,0 +1,19 @@
+package requests
+
+import "strings"
+
+// SetAccept sets the Accept header from one or more media types,
+// joined with commas (e.g. "application/json, text/plain"), saving
+// the caller from building the comma-separated string by hand. Pass
+// a single "type;q=0.x" string per media type if you need explicit
+// quality values.
+func (req *Request) SetAccept(mime ...string) {
+	req.SetHeader("Accept", strings.Join(mime, ", "))
+}
+
+// AcceptJSON sets the Accept header to application/json, so a server
+// returns JSON instead of an HTML error page for clients that don't
+// send an explicit Accept.
+func (req *Request) AcceptJSON() {
+	req.SetAccept("application/json")
+}
@@ -0,0 +1,23 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetAccept(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetAccept("application/json", "text/plain;q=0.5")
+	if v, ok := req.GetHeader("accept"); !ok || v != "application/json, text/plain;q=0.5" {
+		t.Errorf("Accept = %q, %v, want %q, true", v, ok, "application/json, text/plain;q=0.5")
+	}
+}
+
+func TestAcceptJSON(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.AcceptJSON()
+	if v, ok := req.GetHeader("accept"); !ok || v != "application/json" {
+		t.Errorf("Accept = %q, %v, want %q, true", v, ok, "application/json")
+	}
+}
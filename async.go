@@ -0,0 +1,28 @@
+package requests
+
+import "context"
+
+// Result holds the outcome of a request sent via SendAsync.
+type Result struct {
+	Response *Response
+	Err      error
+}
+
+// SendAsync sends the request in a background goroutine, returning a
+// channel that receives exactly one Result once it completes, along
+// with a context.CancelFunc that aborts the in-flight request. This
+// enables UI-driven cancellation of fire-and-forget requests without
+// the caller having to manage the context plumbing themselves.
+func (req *Request) SendAsync() (<-chan Result, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := req.Copy()
+
+	ch := make(chan Result, 1)
+	go func() {
+		res, err := r.SendWithContext(ctx)
+		ch <- Result{Response: res, Err: err}
+	}()
+
+	return ch, cancel
+}
@@ -0,0 +1,61 @@
+package requests_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendAsync(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("async"))
+	}))
+	defer ts.Close()
+
+	ch, cancel := requests.NewGetRequest(ts.URL).SendAsync()
+	defer cancel()
+
+	select {
+	case result := <-ch:
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		if string(result.Response.Body) != "async" {
+			t.Errorf("body = %q, want %q", result.Response.Body, "async")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for async result")
+	}
+}
+
+func TestSendAsyncCancel(t *testing.T) {
+	started := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	ch, cancel := requests.NewGetRequest(ts.URL).SendAsync()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("server never saw the request")
+	}
+	cancel()
+
+	select {
+	case result := <-ch:
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", result.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for async result")
+	}
+}
@@ -0,0 +1,17 @@
+package requests
+
+import "encoding/base64"
+
+// SetBasicAuth sets the authorization header to the Basic scheme,
+// base64-encoding "username:password" the same way net/http's
+// Request.SetBasicAuth does.
+func (req *Request) SetBasicAuth(username, password string) {
+	creds := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	req.SetHeader("authorization", "Basic "+creds)
+}
+
+// SetBearerToken sets the authorization header to the Bearer scheme
+// with the given token, for OAuth/JWT-authenticated APIs.
+func (req *Request) SetBearerToken(token string) {
+	req.SetHeader("authorization", "Bearer "+token)
+}
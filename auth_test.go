@@ -0,0 +1,49 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetBasicAuth(t *testing.T) {
+	var gotUser, gotPass string
+	var gotOk bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotPass, gotOk = r.BasicAuth()
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetBasicAuth("alice", "s3cret")
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if !gotOk {
+		t.Fatal("expected r.BasicAuth() to report credentials present")
+	}
+	if gotUser != "alice" || gotPass != "s3cret" {
+		t.Errorf("got (%q, %q), want (%q, %q)", gotUser, gotPass, "alice", "s3cret")
+	}
+}
+
+func TestSetBearerToken(t *testing.T) {
+	var gotAuth string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetBearerToken("abc123")
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotAuth != "Bearer abc123" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer abc123")
+	}
+}
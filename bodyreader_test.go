@@ -0,0 +1,36 @@
+package requests_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+// TestRequestBodyReaderStreamsWithoutBuffering confirms that setting
+// BodyReader directly (rather than buffering into Body, or going
+// through SetBodyFromReader's content-type sniffing) is enough to
+// stream a request body - useful for callers uploading from a file or
+// pipe where they don't want SetBodyFromReader's sniffing behavior.
+func TestRequestBodyReaderStreamsWithoutBuffering(t *testing.T) {
+	var gotBody []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.Method = requests.POST
+	req.BodyReader = strings.NewReader("streamed payload")
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(gotBody) != "streamed payload" {
+		t.Errorf("body = %q, want %q", gotBody, "streamed payload")
+	}
+}
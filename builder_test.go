@@ -0,0 +1,67 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestRequestBuilder(t *testing.T) {
+	var gotMethod, gotHeader, gotQuery, gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotHeader = r.Header.Get("X-Test")
+		gotQuery = r.URL.Query().Get("a")
+		body := make([]byte, r.ContentLength)
+		r.Body.Read(body)
+		gotBody = string(body)
+	}))
+	defer ts.Close()
+
+	type payload struct {
+		Name string `json:"name"`
+	}
+	res, err := requests.NewRequest(ts.URL).
+		Method(requests.POST).
+		Header("x-test", "hi").
+		Query("a", "b").
+		JSON(payload{Name: "widget"}).
+		Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", res.StatusCode)
+	}
+	if gotMethod != "POST" {
+		t.Errorf("method = %q, want POST", gotMethod)
+	}
+	if gotHeader != "hi" {
+		t.Errorf("header = %q, want %q", gotHeader, "hi")
+	}
+	if gotQuery != "b" {
+		t.Errorf("query = %q, want %q", gotQuery, "b")
+	}
+	if gotBody != `{"name":"widget"}` {
+		t.Errorf("body = %q, want %q", gotBody, `{"name":"widget"}`)
+	}
+}
+
+func TestRequestBuilderDefaultsToGet(t *testing.T) {
+	req, err := requests.NewRequest("http://example.com").Request()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != requests.GET {
+		t.Errorf("Method = %v, want GET", req.Method)
+	}
+}
+
+func TestRequestBuilderDeferredJSONError(t *testing.T) {
+	_, err := requests.NewRequest("http://example.com").JSON(make(chan int)).Request()
+	if err == nil {
+		t.Fatal("expected a marshal error to be deferred")
+	}
+}
@@ -0,0 +1,79 @@
+package requests
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+	"unicode/utf8"
+)
+
+// Text decodes Body as a string, honoring the charset parameter of
+// the response's Content-Type header. UTF-8 (and the absence of a
+// charset, which is treated as UTF-8) is returned as-is; Latin-1/
+// ISO-8859-1 is decoded byte-by-byte, since each byte maps directly
+// to the Unicode code point of the same value. Any other charset
+// returns an error rather than silently mangling the text.
+func (res *Response) Text() (string, error) {
+	charset := "utf-8"
+	if ct, ok := res.GetHeader("content-type"); ok {
+		if _, params, err := mime.ParseMediaType(ct); err == nil {
+			if cs, ok := params["charset"]; ok {
+				charset = cs
+			}
+		}
+	}
+
+	switch strings.ToLower(charset) {
+	case "utf-8", "utf8", "":
+		if !utf8.Valid(res.Body) {
+			return "", fmt.Errorf("requests: Text: body isn't valid UTF-8")
+		}
+		return string(res.Body), nil
+	case "iso-8859-1", "latin1":
+		return decodeLatin1(res.Body), nil
+	case "windows-1252":
+		return decodeWindows1252(res.Body), nil
+	default:
+		return "", fmt.Errorf("requests: Text: unsupported charset %q", charset)
+	}
+}
+
+// decodeLatin1 converts Latin-1/ISO-8859-1 bytes to a UTF-8 string by
+// treating each byte as its own Unicode code point.
+func decodeLatin1(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// windows1252Table maps bytes 0x80-0x9F to their windows-1252 code
+// points, the one range where it diverges from Latin-1/ISO-8859-1 -
+// mostly printable punctuation (curly quotes, em dash, ellipsis) that
+// Latin-1 instead maps to the C1 control range. A zero entry means the
+// byte is unassigned in windows-1252, in which case it falls back to
+// its own byte value, matching the WHATWG encoding standard.
+var windows1252Table = [32]rune{
+	0x20AC, 0, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0, 0x017D, 0,
+	0, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0, 0x017E, 0x0178,
+}
+
+// decodeWindows1252 converts windows-1252 bytes to a UTF-8 string,
+// special-casing 0x80-0x9F via windows1252Table and otherwise
+// behaving exactly like decodeLatin1.
+func decodeWindows1252(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		if c >= 0x80 && c <= 0x9F {
+			if r := windows1252Table[c-0x80]; r != 0 {
+				runes[i] = r
+				continue
+			}
+		}
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
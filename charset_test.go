@@ -0,0 +1,76 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseTextUTF8(t *testing.T) {
+	res := &requests.Response{
+		Headers: map[string]string{"content-type": "text/plain; charset=utf-8"},
+		Body:    []byte("héllo"),
+	}
+	got, err := res.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "héllo" {
+		t.Errorf("Text() = %q, want %q", got, "héllo")
+	}
+}
+
+func TestResponseTextDefaultsToUTF8(t *testing.T) {
+	res := &requests.Response{
+		Body: []byte("plain text"),
+	}
+	got, err := res.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "plain text" {
+		t.Errorf("Text() = %q, want %q", got, "plain text")
+	}
+}
+
+func TestResponseTextLatin1(t *testing.T) {
+	// "café" in ISO-8859-1: c, a, f, 0xE9 (é)
+	res := &requests.Response{
+		Headers: map[string]string{"content-type": "text/plain; charset=iso-8859-1"},
+		Body:    []byte{'c', 'a', 'f', 0xE9},
+	}
+	got, err := res.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "café" {
+		t.Errorf("Text() = %q, want %q", got, "café")
+	}
+}
+
+func TestResponseTextWindows1252(t *testing.T) {
+	// "“café” — nice" with windows-1252 curly quotes (0x93, 0x94) and
+	// em dash (0x97), which ISO-8859-1 would instead decode as C1
+	// control characters.
+	res := &requests.Response{
+		Headers: map[string]string{"content-type": "text/plain; charset=windows-1252"},
+		Body:    []byte{0x93, 'c', 'a', 'f', 0xE9, 0x94, ' ', 0x97, ' ', 'n', 'i', 'c', 'e'},
+	}
+	got, err := res.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "“café” — nice"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
+
+func TestResponseTextUnsupportedCharset(t *testing.T) {
+	res := &requests.Response{
+		Headers: map[string]string{"content-type": "text/plain; charset=shift-jis"},
+		Body:    []byte("hi"),
+	}
+	if _, err := res.Text(); err == nil {
+		t.Fatal("expected an error for an unsupported charset")
+	}
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned by VerifyChecksum when the
+// response body's digest doesn't match the expected value.
+var ErrChecksumMismatch = fmt.Errorf("requests: checksum mismatch")
+
+// VerifyChecksum computes the digest of the response body using algo
+// ("sha256", "sha1", or "md5", case-insensitive) and compares it
+// (hex-encoded, case-insensitive) to expectedHex, returning
+// ErrChecksumMismatch if they differ. This is the integrity check a
+// package manager or updater needs before trusting a downloaded
+// artifact.
+func (res *Response) VerifyChecksum(algo, expectedHex string) error {
+	var sum []byte
+	switch strings.ToLower(algo) {
+	case "sha256":
+		s := sha256.Sum256(res.Body)
+		sum = s[:]
+	case "sha1":
+		s := sha1.Sum(res.Body)
+		sum = s[:]
+	case "md5":
+		s := md5.Sum(res.Body)
+		sum = s[:]
+	default:
+		return fmt.Errorf("requests: unsupported checksum algorithm %q", algo)
+	}
+
+	got := hex.EncodeToString(sum)
+	if !strings.EqualFold(got, expectedHex) {
+		return fmt.Errorf("%w: got %s, expected %s", ErrChecksumMismatch, got, expectedHex)
+	}
+	return nil
+}
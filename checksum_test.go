@@ -0,0 +1,33 @@
+package requests_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseVerifyChecksumSHA256(t *testing.T) {
+	res := &requests.Response{Body: []byte("hello world")}
+	// echo -n "hello world" | sha256sum
+	err := res.VerifyChecksum("sha256", "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResponseVerifyChecksumMismatch(t *testing.T) {
+	res := &requests.Response{Body: []byte("hello world")}
+	err := res.VerifyChecksum("sha256", strings.Repeat("0", 64))
+	if !errors.Is(err, requests.ErrChecksumMismatch) {
+		t.Errorf("err = %v, want ErrChecksumMismatch", err)
+	}
+}
+
+func TestResponseVerifyChecksumUnsupportedAlgo(t *testing.T) {
+	res := &requests.Response{Body: []byte("hello world")}
+	if err := res.VerifyChecksum("crc32", "abc"); err == nil {
+		t.Error("expected an error for an unsupported algorithm")
+	}
+}
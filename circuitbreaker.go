@@ -0,0 +1,72 @@
+package requests
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Send when a Request's Breaker is open,
+// so a retry loop aborts immediately rather than sleeping through the
+// backoff into a service that's known to be down.
+var ErrCircuitOpen = errors.New("requests: circuit breaker is open")
+
+// CircuitBreaker is a simple failure-count circuit breaker: it opens
+// after FailureThreshold consecutive failures and stays open for
+// ResetTimeout before allowing a single trial request through again.
+type CircuitBreaker struct {
+	FailureThreshold int
+	ResetTimeout     time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	open     bool
+}
+
+// NewCircuitBreaker creates a CircuitBreaker that opens after
+// failureThreshold consecutive failures and stays open for
+// resetTimeout before allowing a trial request through.
+func NewCircuitBreaker(failureThreshold int, resetTimeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		FailureThreshold: failureThreshold,
+		ResetTimeout:     resetTimeout,
+	}
+}
+
+// Allow reports whether a request may currently be attempted. Once the
+// breaker has been open for at least ResetTimeout, it allows a single
+// trial request through (half-open) without yet resetting state - that
+// happens when the caller reports the outcome via RecordSuccess or
+// RecordFailure.
+func (cb *CircuitBreaker) Allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if !cb.open {
+		return true
+	}
+	return time.Since(cb.openedAt) >= cb.ResetTimeout
+}
+
+// RecordSuccess closes the breaker and resets the failure count.
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures = 0
+	cb.open = false
+}
+
+// RecordFailure counts a failure, opening the breaker once
+// FailureThreshold consecutive failures have been recorded.
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures++
+	if cb.failures >= cb.FailureThreshold {
+		cb.open = true
+		cb.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,78 @@
+package requests_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendRetriesOnFailure(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			// Force a connection-level failure by closing without a response.
+			hj, _ := w.(http.Hijacker)
+			conn, _, _ := hj.Hijack()
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	req := &requests.Request{
+		Method:       requests.GET,
+		URL:          ts.URL,
+		MaxRetries:   3,
+		RetryBackoff: time.Millisecond,
+	}
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", res.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestSendAbortsEarlyWhenBreakerOpen(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		hj, _ := w.(http.Hijacker)
+		conn, _, _ := hj.Hijack()
+		conn.Close()
+	}))
+	defer ts.Close()
+
+	breaker := requests.NewCircuitBreaker(2, time.Hour)
+	req := &requests.Request{
+		Method:       requests.GET,
+		URL:          ts.URL,
+		MaxRetries:   5,
+		RetryBackoff: time.Millisecond,
+		Breaker:      breaker,
+	}
+
+	_, err := req.Send()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !errors.Is(err, requests.ErrCircuitOpen) {
+		t.Errorf("err = %v, want ErrCircuitOpen", err)
+	}
+	// Breaker opens after 2 failures, so the loop should abort on the
+	// 3rd attempt rather than exhausting all 6 possible attempts.
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
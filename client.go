@@ -0,0 +1,314 @@
+package requests
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// TrailingSlashPolicy controls how Client.Do normalizes a request's
+// URL path before sending it.
+type TrailingSlashPolicy int
+
+const (
+	// TrailingSlashLeave leaves the path exactly as given (the
+	// default).
+	TrailingSlashLeave TrailingSlashPolicy = iota
+
+	// TrailingSlashAdd ensures the path ends with a single slash.
+	TrailingSlashAdd
+
+	// TrailingSlashStrip removes a trailing slash from the path,
+	// unless the path is just "/".
+	TrailingSlashStrip
+)
+
+var duplicateSlashes = regexp.MustCompile(`/{2,}`)
+
+// Client holds defaults that are applied to every Request it sends,
+// so callers don't have to repeat common headers or query params on
+// each call.
+type Client struct {
+	DefaultHeaders map[string]string // Headers merged into every request
+	DefaultQuery   map[string]string // Query params merged into every request
+
+	// Transform, if set, runs on every response body read through Do,
+	// after it's read but before it's stored on the Response. This
+	// lets callers plug in decryption or custom decoding uniformly
+	// across all responses without wrapping every call site.
+	Transform func([]byte) ([]byte, error)
+
+	// TrailingSlashPolicy controls how the request's URL path is
+	// normalized before sending: adding or stripping a trailing
+	// slash, and always collapsing duplicate slashes left over from
+	// joining a base URL with a path. Defaults to TrailingSlashLeave.
+	TrailingSlashPolicy TrailingSlashPolicy
+
+	// OkFunc, if set, is used to compute Response.Ok for every
+	// request sent through Do that doesn't set its own Request.OkFunc.
+	// A request-level OkFunc always takes precedence over this one.
+	OkFunc func(int) bool
+
+	// MinTLSVersion, if set (e.g. tls.VersionTLS12), rejects
+	// connections that negotiate below it. It's applied to the
+	// Client's shared transport the first time it's needed.
+	MinTLSVersion uint16
+
+	// PinnedCertSHA256, if set, restricts the server's leaf
+	// certificate to one of these hex-encoded SHA-256 fingerprints.
+	// A non-matching certificate fails the handshake with
+	// ErrCertificatePinMismatch, even if it's otherwise valid and
+	// trusted. Essential for clients talking to fixed, high-trust
+	// endpoints.
+	PinnedCertSHA256 []string
+
+	mu        sync.Mutex
+	reused    int
+	created   int
+	transport *http.Transport
+}
+
+// ClientStats reports how many of a Client's requests reused an
+// existing connection versus opened a new one, as a quick signal of
+// whether pipelining/keep-alive is actually paying off for a given
+// workload.
+type ClientStats struct {
+	ConnectionsReused  int
+	ConnectionsCreated int
+}
+
+// Stats returns a snapshot of the Client's connection reuse counters,
+// accumulated across every call to Do so far.
+func (c *Client) Stats() ClientStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return ClientStats{
+		ConnectionsReused:  c.reused,
+		ConnectionsCreated: c.created,
+	}
+}
+
+// NewClient creates a new, empty Client.
+func NewClient() *Client {
+	return &Client{
+		DefaultHeaders: make(map[string]string),
+		DefaultQuery:   make(map[string]string),
+	}
+}
+
+// SetDefaultHeader sets a header that will be merged into every
+// request sent through Do, unless the request sets its own value
+// for the same (case-insensitive) key.
+func (c *Client) SetDefaultHeader(name, value string) {
+	if c.DefaultHeaders == nil {
+		c.DefaultHeaders = make(map[string]string)
+	}
+	c.DefaultHeaders[name] = value
+}
+
+// SetDefaultQuery sets a query param that will be merged into every
+// request sent through Do, unless the request sets its own value
+// for the same key.
+func (c *Client) SetDefaultQuery(name, value string) {
+	if c.DefaultQuery == nil {
+		c.DefaultQuery = make(map[string]string)
+	}
+	c.DefaultQuery[name] = value
+}
+
+// ensureTransport returns the Client's shared transport, built lazily
+// from MinTLSVersion/PinnedCertSHA256 the first time it's needed. Every
+// request sent through Do shares this transport, so connections -
+// including any pre-established by Warmup - are pooled per Client
+// rather than relying on net/http's process-wide DefaultTransport.
+func (c *Client) ensureTransport() *http.Transport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.transport == nil {
+		tlsConfig := &tls.Config{MinVersion: c.MinTLSVersion}
+		if len(c.PinnedCertSHA256) > 0 {
+			// Trust is established solely by matching the pin, so the
+			// usual CA-chain verification (which would reject a
+			// pinned-but-otherwise-untrusted cert) is skipped in favor
+			// of VerifyPeerCertificate.
+			tlsConfig.InsecureSkipVerify = true
+			tlsConfig.VerifyPeerCertificate = verifyCertPins(c.PinnedCertSHA256)
+		}
+		c.transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	return c.transport
+}
+
+// Warmup pre-establishes n idle connections to host by sending
+// concurrent HEAD requests through the Client's shared transport, so
+// the first user-facing request to that host doesn't pay the
+// connect/TLS cost. This is meant to run once at startup for
+// services with strict cold-start latency budgets.
+func (c *Client) Warmup(ctx context.Context, host string, n int) error {
+	transport := c.ensureTransport()
+
+	c.mu.Lock()
+	if transport.MaxIdleConnsPerHost < n {
+		transport.MaxIdleConnsPerHost = n
+	}
+	c.mu.Unlock()
+
+	httpClient := &http.Client{Transport: transport}
+
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(ctx, http.MethodHead, host, nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			res, err := httpClient.Do(req)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			res.Body.Close()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyCertPins returns a tls.Config.VerifyPeerCertificate callback
+// that rejects any leaf certificate whose SHA-256 fingerprint isn't
+// in pins (hex-encoded, case-insensitive).
+func verifyCertPins(pins []string) func([][]byte, [][]*x509.Certificate) error {
+	allowed := make(map[string]bool, len(pins))
+	for _, p := range pins {
+		allowed[strings.ToLower(p)] = true
+	}
+
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return ErrCertificatePinMismatch
+		}
+		sum := sha256.Sum256(rawCerts[0])
+		if !allowed[hex.EncodeToString(sum[:])] {
+			return ErrCertificatePinMismatch
+		}
+		return nil
+	}
+}
+
+// normalizeURLPath collapses duplicate slashes in rawURL's path and
+// applies policy to its trailing slash.
+func normalizeURLPath(rawURL string, policy TrailingSlashPolicy) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	path := duplicateSlashes.ReplaceAllString(u.Path, "/")
+
+	switch policy {
+	case TrailingSlashAdd:
+		if !strings.HasSuffix(path, "/") {
+			path += "/"
+		}
+	case TrailingSlashStrip:
+		if path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+	}
+
+	u.Path = path
+	return u.String(), nil
+}
+
+// Do sends req with the Client's defaults merged in: client defaults
+// are applied first, then the request's own headers and query params
+// are layered on top, so they win on conflicts. If a request sets a
+// header or query param to the empty string, that's treated as an
+// explicit clear of the default rather than sending an empty value.
+//
+// Do doesn't mutate req - it sends a copy.
+func (c *Client) Do(req *Request) (*Response, error) {
+	r := req.Copy()
+
+	if u, err := normalizeURLPath(r.URL, c.TrailingSlashPolicy); err == nil {
+		r.URL = u
+	}
+
+	headers := make(map[string]string)
+	for k, v := range c.DefaultHeaders {
+		headers[strings.ToLower(k)] = v
+	}
+	for k, v := range req.Headers {
+		key := strings.ToLower(k)
+		if v == "" {
+			delete(headers, key)
+			continue
+		}
+		headers[key] = v
+	}
+	r.Headers = headers
+
+	query := make(map[string]string)
+	for k, v := range c.DefaultQuery {
+		query[k] = v
+	}
+	for k, v := range req.Query {
+		if v == "" {
+			delete(query, k)
+			continue
+		}
+		query[k] = v
+	}
+	r.Query = query
+
+	if r.OkFunc == nil {
+		r.OkFunc = c.OkFunc
+	}
+
+	r.transport = c.ensureTransport()
+
+	r.trace = &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if info.Reused {
+				c.reused++
+			} else {
+				c.created++
+			}
+		},
+	}
+
+	res, err := r.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Transform != nil {
+		body, err := c.Transform(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		res.Body = body
+	}
+
+	return res, nil
+}
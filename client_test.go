@@ -0,0 +1,242 @@
+package requests_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestClientDoMergesDefaults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("api_key") != "abc" {
+			t.Errorf("api_key = %q, want %q", q.Get("api_key"), "abc")
+		}
+		if q.Get("page") != "2" {
+			t.Errorf("page = %q, want %q", q.Get("page"), "2")
+		}
+		if r.Header.Get("X-From") != "client" {
+			t.Errorf("X-From = %q, want %q", r.Header.Get("X-From"), "client")
+		}
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.SetDefaultQuery("api_key", "abc")
+	c.SetDefaultQuery("page", "1")
+	c.SetDefaultHeader("X-From", "client")
+
+	req := &requests.Request{
+		Method: requests.GET,
+		URL:    ts.URL,
+	}
+	req.SetQuery("page", "2")
+
+	if _, err := c.Do(req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientDoTransform(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("secret-cipher-text"))
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.Transform = func(body []byte) ([]byte, error) {
+		return []byte("decoded"), nil
+	}
+
+	res, err := c.Do(requests.NewGetRequest(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "decoded" {
+		t.Errorf("body = %q, want %q", string(res.Body), "decoded")
+	}
+}
+
+func TestClientDoRequestClearsDefault(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("api_key") {
+			t.Error("expected api_key to be cleared by the request")
+		}
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.SetDefaultQuery("api_key", "abc")
+
+	req := &requests.Request{
+		Method: requests.GET,
+		URL:    ts.URL,
+	}
+	req.SetQuery("api_key", "")
+
+	if _, err := c.Do(req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientStats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	for i := 0; i < 3; i++ {
+		if _, err := c.Do(requests.NewGetRequest(ts.URL)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats := c.Stats()
+	if stats.ConnectionsCreated+stats.ConnectionsReused != 3 {
+		t.Errorf("total connections = %d, want 3", stats.ConnectionsCreated+stats.ConnectionsReused)
+	}
+	if stats.ConnectionsReused == 0 {
+		t.Error("expected at least one connection to be reused across keep-alive requests")
+	}
+}
+
+func TestClientWarmup(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	if err := c.Warmup(context.Background(), ts.URL, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Do(requests.NewGetRequest(ts.URL)); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := c.Stats()
+	if stats.ConnectionsReused == 0 {
+		t.Error("expected the warmed-up connection pool to be reused by Do")
+	}
+}
+
+func TestClientDoAPIKeyDefaultQuery(t *testing.T) {
+	var gotKey string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.URL.Query().Get("api_key")
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.SetDefaultQuery("api_key", "secret-key")
+
+	if _, err := c.Do(requests.NewGetRequest(ts.URL)); err != nil {
+		t.Fatal(err)
+	}
+	if gotKey != "secret-key" {
+		t.Errorf("api_key = %q, want %q", gotKey, "secret-key")
+	}
+}
+
+func TestClientOkFuncFallback(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.OkFunc = func(code int) bool {
+		return code == 404
+	}
+
+	res, err := c.Do(requests.NewGetRequest(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Ok {
+		t.Error("expected Ok = true via the Client's OkFunc")
+	}
+}
+
+func TestClientOkFuncRequestOverride(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.OkFunc = func(code int) bool {
+		return code == 404
+	}
+
+	req := requests.NewGetRequest(ts.URL)
+	req.OkFunc = func(code int) bool {
+		return false
+	}
+
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Ok {
+		t.Error("expected the request-level OkFunc to take precedence over the Client's")
+	}
+}
+
+func TestClientDoTrailingSlashAdd(t *testing.T) {
+	var gotPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.TrailingSlashPolicy = requests.TrailingSlashAdd
+
+	if _, err := c.Do(requests.NewGetRequest(ts.URL + "/y")); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/y/" {
+		t.Errorf("path = %q, want %q", gotPath, "/y/")
+	}
+}
+
+func TestClientDoCollapsesDuplicateSlashes(t *testing.T) {
+	var gotPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+
+	if _, err := c.Do(requests.NewGetRequest(ts.URL + "//y")); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/y" {
+		t.Errorf("path = %q, want %q", gotPath, "/y")
+	}
+}
+
+func TestClientDoTrailingSlashStrip(t *testing.T) {
+	var gotPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.TrailingSlashPolicy = requests.TrailingSlashStrip
+
+	if _, err := c.Do(requests.NewGetRequest(ts.URL + "/y/")); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/y" {
+		t.Errorf("path = %q, want %q", gotPath, "/y")
+	}
+}
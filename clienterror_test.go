@@ -0,0 +1,48 @@
+package requests_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendTimeoutIsErrTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.Timeout = 5 * time.Millisecond
+	_, err := req.Send()
+	if err == nil {
+		t.Fatal("expected a timeout error, got nil")
+	}
+	if !errors.Is(err, requests.ErrTimeout) {
+		t.Errorf("err = %v, want errors.Is(err, requests.ErrTimeout)", err)
+	}
+}
+
+func TestSendConnectionRefusedIsErrConnection(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close() // free the port so nothing is listening on it
+
+	req := requests.NewGetRequest("http://" + addr)
+	_, err = req.Send()
+	if err == nil {
+		t.Fatal("expected a connection error, got nil")
+	}
+	if !errors.Is(err, requests.ErrConnection) {
+		t.Errorf("err = %v, want errors.Is(err, requests.ErrConnection)", err)
+	}
+}
@@ -0,0 +1,87 @@
+package requests_test
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendCompressBody(t *testing.T) {
+	var gotEncoding string
+	var gotBody []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotEncoding = r.Header.Get("Content-Encoding")
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotBody, _ = io.ReadAll(gr)
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "application/json", []byte(`{"a":1}`))
+	req.CompressBody = true
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotEncoding != "gzip" {
+		t.Errorf("Content-Encoding = %q, want %q", gotEncoding, "gzip")
+	}
+	if string(gotBody) != `{"a":1}` {
+		t.Errorf("body = %q, want %q", gotBody, `{"a":1}`)
+	}
+}
+
+func TestSendCompressBodyNoOpForEmptyBody(t *testing.T) {
+	var gotEncoding string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotEncoding = r.Header.Get("Content-Encoding")
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.CompressBody = true
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotEncoding != "" {
+		t.Errorf("Content-Encoding = %q, want empty for an empty body", gotEncoding)
+	}
+}
+
+func TestSendCompressBodyFallsBackOn415(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"a":1}` {
+			t.Errorf("fallback body = %q, want %q", body, `{"a":1}`)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "application/json", []byte(`{"a":1}`))
+	req.CompressBody = true
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", res.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"net/http"
+	"time"
+)
+
+// SetIfNoneMatch sets the If-None-Match header to etag, so a server
+// supporting conditional requests can respond 304 Not Modified
+// instead of resending a body the caller already has.
+func (req *Request) SetIfNoneMatch(etag string) {
+	req.SetHeader("If-None-Match", etag)
+}
+
+// SetIfModifiedSince sets the If-Modified-Since header to t,
+// formatted as an HTTP-date, so a server supporting conditional
+// requests can respond 304 Not Modified instead of resending a body
+// that hasn't changed since t.
+func (req *Request) SetIfModifiedSince(t time.Time) {
+	req.SetHeader("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+}
+
+// NotModified reports whether the response is a 304 Not Modified,
+// the expected response to a conditional request (SetIfNoneMatch or
+// SetIfModifiedSince) when nothing has changed.
+func (res *Response) NotModified() bool {
+	return res.StatusCode == http.StatusNotModified
+}
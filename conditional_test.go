@@ -0,0 +1,64 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetIfNoneMatch(t *testing.T) {
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("If-None-Match")
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetIfNoneMatch(`"abc123"`)
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != `"abc123"` {
+		t.Errorf("If-None-Match = %q, want %q", got, `"abc123"`)
+	}
+	if !res.NotModified() {
+		t.Error("expected NotModified() to be true for a 304 response")
+	}
+}
+
+func TestSetIfModifiedSince(t *testing.T) {
+	when := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("If-Modified-Since")
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetIfModifiedSince(when)
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if got != when.Format(http.TimeFormat) {
+		t.Errorf("If-Modified-Since = %q, want %q", got, when.Format(http.TimeFormat))
+	}
+}
+
+func TestResponseNotModified(t *testing.T) {
+	res := &requests.Response{StatusCode: http.StatusNotModified}
+	if !res.NotModified() {
+		t.Error("expected NotModified() to be true")
+	}
+
+	res = &requests.Response{StatusCode: http.StatusOK}
+	if res.NotModified() {
+		t.Error("expected NotModified() to be false")
+	}
+}
@@ -0,0 +1,104 @@
+package requests
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// sendOverConn writes the request directly to req.Conn and reads the
+// response back from it, bypassing the pooled http.Client entirely.
+// It's a minimal, self-contained path (like scanner.go's) rather than
+// sharing sendOnce's plumbing, since a caller-supplied connection
+// doesn't go through http.Client.Do at all. It's called from inside
+// sendOnce, so Breaker, RateLimit, MaxRetries/RetryBackoff, and
+// RetryOnStatus are still applied by SendWithContext's surrounding
+// retry loop exactly as they would be otherwise.
+//
+// Because there's no http.Client or transport involved, it honors
+// URL, Method, Headers, HeaderValues, AutoDecompress/
+// MaxDecompressedSize, and OkFunc, along with DefaultHeaders/
+// DefaultUserAgent. It does not consult TokenSource, SignRequest,
+// CompressBody, Timeout, Proxy, or TLSConfig - those are all applied
+// in sendOnce's http.Client branch, which a raw Conn bypasses
+// entirely.
+func (req *Request) sendOverConn() (*Response, error) {
+	u, err := req.getURL()
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := http.NewRequest(req.Method.String(), u, req.getReqBody())
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	for k, v := range DefaultHeaders {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, v := range req.Headers {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, vs := range req.HeaderValues {
+		for _, v := range vs {
+			httpRequest.Header.Add(k, v)
+		}
+	}
+	if httpRequest.Header.Get("User-Agent") == "" {
+		httpRequest.Header.Set("User-Agent", DefaultUserAgent)
+	}
+
+	if err := httpRequest.Write(req.Conn); err != nil {
+		return nil, fmt.Errorf("error writing request to conn: %w", err)
+	}
+
+	readStart := time.Now()
+	httpResponse, err := http.ReadResponse(bufio.NewReader(req.Conn), httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response from conn: %w", err)
+	}
+	defer httpResponse.Body.Close()
+
+	rHeaders := make(map[string]string)
+	rHeaderValues := make(map[string][]string)
+	for k, v := range httpResponse.Header {
+		if len(v) > 0 {
+			lowerKey := strings.ToLower(k)
+			rHeaders[lowerKey] = v[0]
+			rHeaderValues[lowerKey] = v
+		}
+	}
+
+	body, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	readElapsed := time.Since(readStart)
+
+	if req.AutoDecompress {
+		body, err = sniffDecompress(body, req.MaxDecompressedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ok := httpResponse.StatusCode < 400
+	if req.OkFunc != nil {
+		ok = req.OkFunc(httpResponse.StatusCode)
+	}
+	res := Response{
+		Ok:           ok,
+		StatusCode:   httpResponse.StatusCode,
+		Headers:      rHeaders,
+		HeaderValues: rHeaderValues,
+		Body:         body,
+		FinalURL:     u,
+	}
+	if readElapsed > 0 {
+		res.BytesPerSecond = float64(len(body)) / readElapsed.Seconds()
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,80 @@
+package requests_test
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendOverConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+
+	go func() {
+		defer server.Close()
+		r, err := http.ReadRequest(bufio.NewReader(server))
+		if err != nil {
+			return
+		}
+		r.Body.Close()
+
+		resp := "HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nhello"
+		server.Write([]byte(resp))
+	}()
+
+	req := requests.NewGetRequest("http://example.com/path")
+	req.Conn = client
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 {
+		t.Errorf("StatusCode = %d, want 200", res.StatusCode)
+	}
+	if string(res.Body) != "hello" {
+		t.Errorf("Body = %q, want %q", res.Body, "hello")
+	}
+}
+
+func TestSendOverConnIgnoresTokenSourceAndSignRequest(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+
+	var gotAuth, gotSig string
+	go func() {
+		defer server.Close()
+		r, err := http.ReadRequest(bufio.NewReader(server))
+		if err != nil {
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		gotSig = r.Header.Get("X-Signature")
+		r.Body.Close()
+
+		resp := "HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nhello"
+		server.Write([]byte(resp))
+	}()
+
+	req := requests.NewGetRequest("http://example.com/path")
+	req.Conn = client
+	req.TokenSource = staticTokenSource{token: "from-source"}
+	req.SignRequest = func(r *http.Request) error {
+		r.Header.Set("X-Signature", "signed")
+		return nil
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotAuth != "" {
+		t.Errorf("Authorization = %q, want empty - sendOverConn shouldn't consult TokenSource", gotAuth)
+	}
+	if gotSig != "" {
+		t.Errorf("X-Signature = %q, want empty - sendOverConn shouldn't consult SignRequest", gotSig)
+	}
+}
@@ -0,0 +1,44 @@
+package requests
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ContentRange parses the response's Content-Range header (e.g.
+// "bytes 0-1023/146515") and returns the byte range received and the
+// total size of the resource, along with whether the header was
+// present and well-formed. It's meant for reassembling partial
+// (206) responses from resumed or parallel range downloads.
+func (res *Response) ContentRange() (start, end, total int64, ok bool) {
+	h, hasHeader := res.GetHeader("content-range")
+	if !hasHeader {
+		return 0, 0, 0, false
+	}
+
+	h = strings.TrimPrefix(h, "bytes ")
+	rangeAndTotal := strings.SplitN(h, "/", 2)
+	if len(rangeAndTotal) != 2 {
+		return 0, 0, 0, false
+	}
+
+	startAndEnd := strings.SplitN(rangeAndTotal[0], "-", 2)
+	if len(startAndEnd) != 2 {
+		return 0, 0, 0, false
+	}
+
+	start, err := strconv.ParseInt(startAndEnd[0], 10, 64)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	end, err = strconv.ParseInt(startAndEnd[1], 10, 64)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	total, err = strconv.ParseInt(rangeAndTotal[1], 10, 64)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return start, end, total, true
+}
@@ -0,0 +1,37 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseContentRange(t *testing.T) {
+	res := &requests.Response{
+		Headers: map[string]string{"content-range": "bytes 0-1023/146515"},
+	}
+
+	start, end, total, ok := res.ContentRange()
+	if !ok {
+		t.Fatal("expected ContentRange to parse successfully")
+	}
+	if start != 0 || end != 1023 || total != 146515 {
+		t.Errorf("got (%d, %d, %d), want (0, 1023, 146515)", start, end, total)
+	}
+}
+
+func TestResponseContentRangeMissing(t *testing.T) {
+	res := &requests.Response{}
+	if _, _, _, ok := res.ContentRange(); ok {
+		t.Error("expected ok = false when Content-Range is absent")
+	}
+}
+
+func TestResponseContentRangeMalformed(t *testing.T) {
+	res := &requests.Response{
+		Headers: map[string]string{"content-range": "not-a-range"},
+	}
+	if _, _, _, ok := res.ContentRange(); ok {
+		t.Error("expected ok = false for a malformed Content-Range")
+	}
+}
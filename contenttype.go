@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"mime"
+	"strings"
+)
+
+// ContentType returns the response's media type, stripping any
+// parameters (like charset) via mime.ParseMediaType. Returns "" if
+// there's no Content-Type header, or if it's malformed.
+func (res *Response) ContentType() string {
+	ct, ok := res.GetHeader("content-type")
+	if !ok {
+		return ""
+	}
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// IsJSON reports whether the response's media type is
+// "application/json" or ends in the "+json" suffix (e.g.
+// "application/vnd.api+json"), so callers can check it's safe to call
+// JSON or JSONInto before doing so.
+func (res *Response) IsJSON() bool {
+	ct := res.ContentType()
+	return ct == "application/json" || strings.HasSuffix(ct, "+json")
+}
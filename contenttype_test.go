@@ -0,0 +1,55 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseContentType(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		ok     bool
+		out    string
+	}{
+		{"plain json", "application/json", true, "application/json"},
+		{"json with charset", "application/json; charset=utf-8", true, "application/json"},
+		{"html", "text/html; charset=utf-8", true, "text/html"},
+		{"missing", "", false, ""},
+	}
+
+	for _, tc := range testCases {
+		res := &requests.Response{}
+		if tc.ok {
+			res.Headers = map[string]string{"Content-Type": tc.header}
+		}
+		if ct := res.ContentType(); ct != tc.out {
+			t.Errorf("%s: ContentType() = %q, want %q", tc.name, ct, tc.out)
+		}
+	}
+}
+
+func TestResponseIsJSON(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		out    bool
+	}{
+		{"plain json", "application/json", true},
+		{"json with charset", "application/json; charset=utf-8", true},
+		{"vendor json suffix", "application/vnd.api+json", true},
+		{"html", "text/html", false},
+		{"missing", "", false},
+	}
+
+	for _, tc := range testCases {
+		res := &requests.Response{}
+		if tc.header != "" {
+			res.Headers = map[string]string{"Content-Type": tc.header}
+		}
+		if is := res.IsJSON(); is != tc.out {
+			t.Errorf("%s: IsJSON() = %v, want %v", tc.name, is, tc.out)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package requests_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendWithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ctx"))
+	}))
+	defer ts.Close()
+
+	res, err := requests.NewGetRequest(ts.URL).SendWithContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "ctx" {
+		t.Errorf("body = %q, want %q", res.Body, "ctx")
+	}
+}
+
+func TestSendWithContextCancelMidDownload(t *testing.T) {
+	started := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := requests.NewGetRequest(ts.URL)
+
+	resCh := make(chan requests.Result, 1)
+	go func() {
+		res, err := req.SendWithContext(ctx)
+		resCh <- requests.Result{Response: res, Err: err}
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("server never saw the request")
+	}
+	cancel()
+
+	select {
+	case result := <-resCh:
+		if result.Response != nil {
+			t.Errorf("Response = %v, want nil on cancellation", result.Response)
+		}
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", result.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for result")
+	}
+}
@@ -0,0 +1,54 @@
+package requests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SetRawCookie sets the Cookie header directly from a raw
+// "a=1; b=2" string, as you'd copy out of a browser's dev tools. It
+// validates that s looks like a well-formed cookie list before
+// setting it, returning an error otherwise.
+func (req *Request) SetRawCookie(s string) error {
+	if err := validateRawCookie(s); err != nil {
+		return err
+	}
+	req.SetHeader("Cookie", s)
+	return nil
+}
+
+// Cookies parses every Set-Cookie header on the response (via
+// HeaderValues, which keeps repeats that the single-valued Headers
+// map would collapse down to just the first) into *http.Cookie
+// values, giving access to each cookie's expiry and flags alongside
+// its name and value.
+func (res *Response) Cookies() []*http.Cookie {
+	h := http.Header{"Set-Cookie": res.HeaderValues["set-cookie"]}
+	return (&http.Response{Header: h}).Cookies()
+}
+
+// validateRawCookie checks that s is a semicolon-separated list of
+// non-empty "name=value" pairs.
+func validateRawCookie(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("requests: raw cookie is empty")
+	}
+
+	for _, pair := range strings.Split(s, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			return fmt.Errorf("requests: raw cookie %q has an empty pair", s)
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("requests: raw cookie pair %q is missing '='", pair)
+		}
+		if strings.TrimSpace(parts[0]) == "" {
+			return fmt.Errorf("requests: raw cookie pair %q is missing a name", pair)
+		}
+	}
+
+	return nil
+}
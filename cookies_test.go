@@ -0,0 +1,62 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseCookies(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc123"})
+		http.SetCookie(w, &http.Cookie{Name: "theme", Value: "dark"})
+	}))
+	defer ts.Close()
+
+	res, err := requests.NewGetRequest(ts.URL).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cookies := res.Cookies()
+	if len(cookies) != 2 {
+		t.Fatalf("len(cookies) = %d, want 2", len(cookies))
+	}
+
+	byName := make(map[string]string, len(cookies))
+	for _, c := range cookies {
+		byName[c.Name] = c.Value
+	}
+	if byName["session"] != "abc123" {
+		t.Errorf("session = %q, want %q", byName["session"], "abc123")
+	}
+	if byName["theme"] != "dark" {
+		t.Errorf("theme = %q, want %q", byName["theme"], "dark")
+	}
+}
+
+func TestSetRawCookie(t *testing.T) {
+	r := &requests.Request{}
+	if err := r.SetRawCookie("a=1; b=2"); err != nil {
+		t.Fatal(err)
+	}
+	got, ok := r.GetHeader("cookie")
+	if !ok {
+		t.Fatal("cookie header not set")
+	}
+	if got != "a=1; b=2" {
+		t.Errorf("cookie = %q, want %q", got, "a=1; b=2")
+	}
+}
+
+func TestSetRawCookieInvalid(t *testing.T) {
+	cases := []string{"", "a", "a=1; ", "=1"}
+	for _, c := range cases {
+		r := &requests.Request{}
+		if err := r.SetRawCookie(c); err == nil {
+			t.Errorf("SetRawCookie(%q) expected an error, got nil", c)
+		}
+	}
+}
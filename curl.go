@@ -0,0 +1,189 @@
+package requests
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ToCurl renders req as an equivalent curl command line - method,
+// headers, query params (folded into the URL by getURL), and body -
+// the inverse of ParseCurl. It's handy when a request misbehaves and
+// you want to reproduce it straight from a terminal.
+func (req *Request) ToCurl() (string, error) {
+	u, err := req.getURL()
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	buf.WriteString("curl")
+
+	if req.Method != GET {
+		fmt.Fprintf(&buf, " -X %s", shellQuote(req.Method.String()))
+	}
+
+	for k, v := range DefaultHeaders {
+		fmt.Fprintf(&buf, " -H %s", shellQuote(k+": "+v))
+	}
+	for k, v := range req.Headers {
+		fmt.Fprintf(&buf, " -H %s", shellQuote(k+": "+v))
+	}
+	for k, vs := range req.HeaderValues {
+		for _, v := range vs {
+			fmt.Fprintf(&buf, " -H %s", shellQuote(k+": "+v))
+		}
+	}
+
+	if data, err := req.peekReqBody(); err != nil {
+		return "", err
+	} else if data != nil {
+		flag := "--data"
+		if !utf8.Valid(data) {
+			flag = "--data-binary"
+		}
+		fmt.Fprintf(&buf, " %s %s", flag, shellQuote(string(data)))
+	}
+
+	fmt.Fprintf(&buf, " %s", shellQuote(u))
+
+	return buf.String(), nil
+}
+
+// shellQuote wraps s in single quotes for use as a single POSIX shell
+// argument, escaping any embedded single quotes so the result is safe
+// to paste into a terminal regardless of s's contents.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// ParseCurl parses a curl command line into a Request. It supports
+// -X/--request, -H/--header, -d/--data/--data-raw, -u/--user, and a
+// bare URL argument - the common subset people paste out of browser
+// dev tools or shell history when migrating from curl scripts.
+func ParseCurl(cmd string) (*Request, error) {
+	tokens, err := tokenizeCurl(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) > 0 && tokens[0] == "curl" {
+		tokens = tokens[1:]
+	}
+
+	req := &Request{Method: GET}
+	haveMethod := false
+	haveBody := false
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+
+		switch tok {
+		case "-X", "--request":
+			val, err := nextCurlArg(tokens, &i, tok)
+			if err != nil {
+				return nil, err
+			}
+			m, err := ParseHTTPMethod(val)
+			if err != nil {
+				return nil, err
+			}
+			req.Method = m
+			haveMethod = true
+
+		case "-H", "--header":
+			val, err := nextCurlArg(tokens, &i, tok)
+			if err != nil {
+				return nil, err
+			}
+			name, value, ok := strings.Cut(val, ":")
+			if !ok {
+				return nil, fmt.Errorf("requests: invalid curl header %q", val)
+			}
+			req.SetHeader(strings.TrimSpace(name), strings.TrimSpace(value))
+
+		case "-d", "--data", "--data-raw":
+			val, err := nextCurlArg(tokens, &i, tok)
+			if err != nil {
+				return nil, err
+			}
+			req.Body = append(req.Body, []byte(val)...)
+			haveBody = true
+
+		case "-u", "--user":
+			val, err := nextCurlArg(tokens, &i, tok)
+			if err != nil {
+				return nil, err
+			}
+			req.SetHeader("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(val)))
+
+		default:
+			if strings.HasPrefix(tok, "-") {
+				return nil, fmt.Errorf("requests: unsupported curl flag %q", tok)
+			}
+			req.URL = tok
+		}
+	}
+
+	if req.URL == "" {
+		return nil, fmt.Errorf("requests: no URL found in curl command")
+	}
+	if haveBody && !haveMethod {
+		req.Method = POST
+	}
+
+	return req, nil
+}
+
+// nextCurlArg returns the argument following a flag at tokens[*i],
+// advancing *i past it.
+func nextCurlArg(tokens []string, i *int, flag string) (string, error) {
+	if *i+1 >= len(tokens) {
+		return "", fmt.Errorf("requests: curl flag %q is missing its argument", flag)
+	}
+	*i++
+	return tokens[*i], nil
+}
+
+// tokenizeCurl splits a curl command line into arguments, honoring
+// single and double quoted strings the way a shell would.
+func tokenizeCurl(cmd string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+	inToken := false
+
+	runes := []rune(cmd)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\'' || c == '"':
+			quote := c
+			i++
+			start := i
+			for i < len(runes) && runes[i] != quote {
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("requests: unterminated %c quote in curl command", quote)
+			}
+			cur.WriteString(string(runes[start:i]))
+			inToken = true
+
+		case c == ' ' || c == '\t' || c == '\n':
+			if inToken {
+				tokens = append(tokens, cur.String())
+				cur.Reset()
+				inToken = false
+			}
+
+		default:
+			cur.WriteRune(c)
+			inToken = true
+		}
+	}
+	if inToken {
+		tokens = append(tokens, cur.String())
+	}
+
+	return tokens, nil
+}
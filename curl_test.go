@@ -0,0 +1,181 @@
+package requests_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestParseCurl(t *testing.T) {
+	cmd := `curl -X POST -H "Content-Type: application/json" -d '{"a":1}' https://example.com/api`
+	req, err := requests.ParseCurl(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Method != requests.POST {
+		t.Errorf("method = %s, want POST", req.Method)
+	}
+	if req.URL != "https://example.com/api" {
+		t.Errorf("url = %q, want %q", req.URL, "https://example.com/api")
+	}
+	ct, ok := req.GetHeader("content-type")
+	if !ok || ct != "application/json" {
+		t.Errorf("content-type = %q, %v, want %q, true", ct, ok, "application/json")
+	}
+	if string(req.Body) != `{"a":1}` {
+		t.Errorf("body = %q, want %q", string(req.Body), `{"a":1}`)
+	}
+}
+
+func TestParseCurlGetDefault(t *testing.T) {
+	req, err := requests.ParseCurl(`curl https://example.com`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != requests.GET {
+		t.Errorf("method = %s, want GET", req.Method)
+	}
+}
+
+func TestParseCurlBasicAuth(t *testing.T) {
+	req, err := requests.ParseCurl(`curl -u user:pass https://example.com`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, ok := req.GetHeader("authorization")
+	if !ok {
+		t.Fatal("authorization header not set")
+	}
+	if auth != "Basic dXNlcjpwYXNz" {
+		t.Errorf("authorization = %q, want %q", auth, "Basic dXNlcjpwYXNz")
+	}
+}
+
+func TestParseCurlMissingURL(t *testing.T) {
+	if _, err := requests.ParseCurl(`curl -X GET`); err == nil {
+		t.Error("expected an error for missing URL")
+	}
+}
+
+func TestRequestToCurl(t *testing.T) {
+	req := requests.NewPostRequest("https://example.com/api", "application/json", []byte(`{"a":1}`))
+	req.SetHeader("Content-Type", "application/json")
+
+	out, err := req.ToCurl()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"curl", "-X 'POST'", "-H 'content-type: application/json'", `--data '{"a":1}'`, "'https://example.com/api'"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("ToCurl() = %q, want it to contain %q", out, want)
+		}
+	}
+}
+
+func TestRequestToCurlGetOmitsMethodFlag(t *testing.T) {
+	req := requests.NewGetRequest("https://example.com")
+	out, err := req.ToCurl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "-X") {
+		t.Errorf("ToCurl() = %q, want no -X flag for a GET request", out)
+	}
+}
+
+func TestRequestToCurlEscapesSingleQuotes(t *testing.T) {
+	req := requests.NewGetRequest("https://example.com")
+	req.SetHeader("X-Note", "it's a test")
+
+	out, err := req.ToCurl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, `it'\''s a test`) {
+		t.Errorf("ToCurl() = %q, want escaped single quote", out)
+	}
+}
+
+func TestRequestToCurlRoundTripsThroughParseCurl(t *testing.T) {
+	req := requests.NewPostRequest("https://example.com/api", "text/plain", []byte("hello"))
+	req.SetHeader("X-Token", "abc123")
+
+	out, err := req.ToCurl()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := requests.ParseCurl(out)
+	if err != nil {
+		t.Fatalf("ParseCurl(%q): %v", out, err)
+	}
+	if parsed.Method != requests.POST {
+		t.Errorf("method = %s, want POST", parsed.Method)
+	}
+	if parsed.URL != "https://example.com/api" {
+		t.Errorf("url = %q, want %q", parsed.URL, "https://example.com/api")
+	}
+	if string(parsed.Body) != "hello" {
+		t.Errorf("body = %q, want %q", parsed.Body, "hello")
+	}
+	tok, ok := parsed.GetHeader("x-token")
+	if !ok || tok != "abc123" {
+		t.Errorf("x-token = %q, %v, want %q, true", tok, ok, "abc123")
+	}
+}
+
+func TestRequestToCurlDoesNotDrainBodyReader(t *testing.T) {
+	var gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, 64)
+		n, _ := r.Body.Read(buf)
+		gotBody = string(buf[:n])
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "text/plain", nil)
+	req.BodyReader = strings.NewReader("streamed payload")
+
+	if _, err := req.ToCurl(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotBody != "streamed payload" {
+		t.Errorf("body after ToCurl+Send = %q, want %q", gotBody, "streamed payload")
+	}
+}
+
+func TestRequestToCurlDoesNotDrainNonSeekableBodyReader(t *testing.T) {
+	var gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, 64)
+		n, _ := r.Body.Read(buf)
+		gotBody = string(buf[:n])
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "text/plain", nil)
+	req.BodyReader = io.NopCloser(strings.NewReader("streamed payload"))
+
+	if _, err := req.ToCurl(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotBody != "streamed payload" {
+		t.Errorf("body after ToCurl+Send = %q, want %q", gotBody, "streamed payload")
+	}
+}
@@ -0,0 +1,112 @@
+package requests
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+)
+
+// ErrDecompressedTooLarge is returned when decompressing a response
+// body would exceed a Request's MaxDecompressedSize.
+var ErrDecompressedTooLarge = errors.New("requests: decompressed body exceeds MaxDecompressedSize")
+
+// gzipMagic is the leading bytes that identify a gzip-encoded stream,
+// regardless of what (if anything) the Content-Encoding header claims.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// looksLikeZlib reports whether body starts with a valid zlib CMF/FLG
+// header. The low nibble of the first byte must be 8 (the "deflate"
+// compression method, the only one zlib defines), and the two header
+// bytes read as a big-endian uint16 must be a multiple of 31 - that's
+// the check/FCHECK constraint zlib's header always satisfies. Unlike
+// matching a single literal byte pair (e.g. 0x78 0x9c, the "default
+// compression level" header), this recognizes every valid zlib header
+// regardless of compression level or dictionary flag.
+func looksLikeZlib(body []byte) bool {
+	if len(body) < 2 {
+		return false
+	}
+	return body[0]&0x0f == 8 && (uint16(body[0])<<8+uint16(body[1]))%31 == 0
+}
+
+// sniffDecompress inspects the first bytes of body for gzip/zlib magic
+// numbers and decompresses it if a match is found. If body doesn't
+// start with a recognized magic number, it's returned unchanged.
+//
+// maxSize, if greater than zero, bounds the decompressed size; exceeding
+// it returns ErrDecompressedTooLarge instead of an unbounded result.
+func sniffDecompress(body []byte, maxSize int64) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(body, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return readLimited(r, maxSize)
+	case looksLikeZlib(body):
+		r, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return readLimited(r, maxSize)
+	default:
+		return body, nil
+	}
+}
+
+// NegotiateContentEncoding sets an Accept-Encoding header advertising
+// gzip and deflate support and turns on AutoDecompress. Setting the
+// header ourselves is what matters: net/http only performs its
+// built-in (gzip-only) transparent decompression when the request
+// doesn't already carry an Accept-Encoding header, so this opts the
+// request out of that and routes every encoding we understand through
+// the single sniffDecompress path instead of split between stdlib and
+// us. br is deliberately not advertised: sniffDecompress only
+// recognizes gzip/zlib magic bytes, and this package has no brotli
+// decoder, so claiming support for it would silently hand callers raw
+// brotli bytes instead of an error.
+func (req *Request) NegotiateContentEncoding() {
+	req.SetHeader("Accept-Encoding", "gzip, deflate")
+	req.AutoDecompress = true
+}
+
+// compressGzip reads all of r and returns it gzip-compressed, for use
+// as a request body when CompressBody is set.
+func compressGzip(r io.Reader) (*bytes.Buffer, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// readLimited reads all of r, unless maxSize is positive, in which case
+// it reads at most maxSize+1 bytes and returns ErrDecompressedTooLarge
+// if more than maxSize bytes were available.
+func readLimited(r io.Reader, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		return io.ReadAll(r)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrDecompressedTooLarge
+	}
+	return data, nil
+}
@@ -0,0 +1,146 @@
+package requests_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestAutoDecompressGzipWithoutHeader(t *testing.T) {
+	buf := bytes.Buffer{}
+	gw := gzip.NewWriter(&buf)
+	gw.Write([]byte("Hello, World!"))
+	gw.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Note: intentionally not setting Content-Encoding, to simulate
+		// a misconfigured server.
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	req := requests.Request{
+		URL:            ts.URL,
+		Method:         requests.GET,
+		AutoDecompress: true,
+	}
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "Hello, World!" {
+		t.Errorf("body = %q, want %q", string(res.Body), "Hello, World!")
+	}
+}
+
+func TestAutoDecompressMaxSize(t *testing.T) {
+	buf := bytes.Buffer{}
+	gw := gzip.NewWriter(&buf)
+	gw.Write([]byte("Hello, World!"))
+	gw.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	req := requests.Request{
+		URL:                 ts.URL,
+		Method:              requests.GET,
+		AutoDecompress:      true,
+		MaxDecompressedSize: 5,
+	}
+	_, err := req.Send()
+	if !errors.Is(err, requests.ErrDecompressedTooLarge) {
+		t.Errorf("err = %v, want ErrDecompressedTooLarge", err)
+	}
+}
+
+func TestNegotiateContentEncoding(t *testing.T) {
+	buf := bytes.Buffer{}
+	gw := gzip.NewWriter(&buf)
+	gw.Write([]byte("Hello, World!"))
+	gw.Close()
+
+	var gotHeader string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("Accept-Encoding")
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	req := requests.Request{
+		URL:    ts.URL,
+		Method: requests.GET,
+	}
+	req.NegotiateContentEncoding()
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotHeader != "gzip, deflate" {
+		t.Errorf("Accept-Encoding = %q, want %q", gotHeader, "gzip, deflate")
+	}
+	if string(res.Body) != "Hello, World!" {
+		t.Errorf("body = %q, want %q", string(res.Body), "Hello, World!")
+	}
+}
+
+func TestAutoDecompressZlibWithoutHeader(t *testing.T) {
+	buf := bytes.Buffer{}
+	// BestSpeed produces a 0x78 0x01 header, not the 0x78 0x9c
+	// "default compression level" header - both are valid zlib.
+	zw, err := zlib.NewWriterLevel(&buf, zlib.BestSpeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw.Write([]byte("Hello, World!"))
+	zw.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Note: intentionally not setting Content-Encoding, to simulate
+		// a misconfigured server.
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	req := requests.Request{
+		URL:            ts.URL,
+		Method:         requests.GET,
+		AutoDecompress: true,
+	}
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "Hello, World!" {
+		t.Errorf("body = %q, want %q", string(res.Body), "Hello, World!")
+	}
+}
+
+func TestAutoDecompressOffByDefault(t *testing.T) {
+	buf := bytes.Buffer{}
+	gw := gzip.NewWriter(&buf)
+	gw.Write([]byte("Hello, World!"))
+	gw.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	res, err := requests.SendGetRequest(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(res.Body, []byte("Hello, World!")) {
+		t.Error("expected body to remain compressed when AutoDecompress is off")
+	}
+}
@@ -0,0 +1,9 @@
+package requests
+
+// DefaultHeaders holds headers merged into every request sent via
+// Send, SendStream, or sendOverConn, before that request's own
+// Headers/HeaderValues are applied - so a per-request header of the
+// same name always wins. Use it for headers like an API key or
+// Accept: application/json that apply across an entire program,
+// without repeating SetHeader on every call.
+var DefaultHeaders = map[string]string{}
@@ -0,0 +1,57 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendAppliesDefaultHeaders(t *testing.T) {
+	orig := requests.DefaultHeaders
+	defer func() { requests.DefaultHeaders = orig }()
+	requests.DefaultHeaders = map[string]string{
+		"Accept":    "application/json",
+		"X-Api-Key": "shared-key",
+	}
+
+	var gotAccept, gotAPIKey string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept")
+		gotAPIKey = r.Header.Get("X-Api-Key")
+	}))
+	defer ts.Close()
+
+	if _, err := requests.NewGetRequest(ts.URL).Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotAccept != "application/json" {
+		t.Errorf("Accept = %q, want %q", gotAccept, "application/json")
+	}
+	if gotAPIKey != "shared-key" {
+		t.Errorf("X-Api-Key = %q, want %q", gotAPIKey, "shared-key")
+	}
+}
+
+func TestSendPerRequestHeaderOverridesDefault(t *testing.T) {
+	orig := requests.DefaultHeaders
+	defer func() { requests.DefaultHeaders = orig }()
+	requests.DefaultHeaders = map[string]string{"Accept": "application/json"}
+
+	var gotAccept string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept")
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetHeader("Accept", "text/plain")
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotAccept != "text/plain" {
+		t.Errorf("Accept = %q, want %q", gotAccept, "text/plain")
+	}
+}
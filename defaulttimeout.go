@@ -0,0 +1,9 @@
+package requests
+
+import "time"
+
+// DefaultTimeout is applied by Send whenever a Request's own Timeout
+// is zero, so a fleet of requests can get a safe default in one place
+// instead of every caller remembering to set Timeout themselves.
+// Zero (the default) preserves the original no-timeout behavior.
+var DefaultTimeout time.Duration
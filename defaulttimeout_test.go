@@ -0,0 +1,44 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendAppliesDefaultTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	orig := requests.DefaultTimeout
+	requests.DefaultTimeout = 5 * time.Millisecond
+	defer func() { requests.DefaultTimeout = orig }()
+
+	req := requests.NewGetRequest(ts.URL)
+	if _, err := req.Send(); err == nil {
+		t.Error("expected a timeout error from DefaultTimeout")
+	}
+}
+
+func TestSendPerRequestTimeoutOverridesDefault(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	orig := requests.DefaultTimeout
+	requests.DefaultTimeout = time.Millisecond
+	defer func() { requests.DefaultTimeout = orig }()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.Timeout = time.Second
+	if _, err := req.Send(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package requests
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/textproto"
+)
+
+// DumpRequest returns the exact bytes that would go out on the wire
+// for this request - request line, headers, and body - as serialized
+// by httputil.DumpRequestOut. It's invaluable when an API rejects a
+// request and you need to see precisely what was transmitted, e.g.
+// while debugging signing or encoding issues.
+func (req *Request) DumpRequest() ([]byte, error) {
+	u, err := req.getURL()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := req.peekReqBody()
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	httpRequest, err := http.NewRequest(req.Method.String(), u, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	for k, v := range DefaultHeaders {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, v := range req.Headers {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, vs := range req.HeaderValues {
+		for _, v := range vs {
+			httpRequest.Header.Add(k, v)
+		}
+	}
+	if httpRequest.Header.Get("User-Agent") == "" {
+		httpRequest.Header.Set("User-Agent", DefaultUserAgent)
+	}
+
+	return httputil.DumpRequestOut(httpRequest, true)
+}
+
+// Dump is an alias for DumpRequest, kept for symmetry with
+// Response.Dump so request/response pairs can be logged with the
+// same method name regardless of which side you're holding.
+func (req *Request) Dump() ([]byte, error) {
+	return req.DumpRequest()
+}
+
+// Size estimates the number of bytes this request would put on the
+// wire, by summing the length of its dumped request line, headers,
+// and body. Useful for bandwidth accounting and quota enforcement
+// before actually sending.
+func (req *Request) Size() (int64, error) {
+	dump, err := req.DumpRequest()
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(dump)), nil
+}
+
+// Dump returns a wire-format serialization of the response - status
+// line, headers, and body - reconstructed from the parsed Response,
+// since the original http.Response isn't kept around past Send.
+func (res *Response) Dump() ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "HTTP/1.1 %d %s\r\n", res.StatusCode, http.StatusText(res.StatusCode))
+	for k, v := range res.Headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", textproto.CanonicalMIMEHeaderKey(k), v)
+	}
+	buf.WriteString("\r\n")
+	buf.Write(res.Body)
+
+	return buf.Bytes(), nil
+}
+
+// Size estimates the number of bytes this response occupied on the
+// wire, by summing the length of its dumped status line, headers, and
+// body. The counterpart to Request.Size for tracking total bytes
+// in/out per request.
+func (res *Response) Size() (int64, error) {
+	dump, err := res.Dump()
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(dump)), nil
+}
@@ -0,0 +1,119 @@
+package requests_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestDumpRequest(t *testing.T) {
+	req := requests.NewPostRequest("http://example.com/api", "application/json", []byte(`{"a":1}`))
+
+	dump, err := req.DumpRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := string(dump)
+	if !strings.HasPrefix(s, "POST /api") {
+		t.Errorf("dump doesn't start with request line, got %q", s)
+	}
+	if !strings.Contains(s, `{"a":1}`) {
+		t.Errorf("dump missing body, got %q", s)
+	}
+}
+
+func TestRequestDump(t *testing.T) {
+	req := requests.NewPostRequest("http://example.com/api", "application/json", []byte(`{"a":1}`))
+
+	dump, err := req.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(dump), "POST /api") {
+		t.Errorf("dump doesn't start with request line, got %q", dump)
+	}
+}
+
+func TestRequestSize(t *testing.T) {
+	req := requests.NewPostRequest("http://example.com/api", "application/json", []byte(`{"a":1}`))
+
+	size, err := req.Size()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size <= int64(len(`{"a":1}`)) {
+		t.Errorf("size = %d, want more than just the body length", size)
+	}
+}
+
+func TestDumpRequestDoesNotDrainBodyReader(t *testing.T) {
+	var gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, 64)
+		n, _ := r.Body.Read(buf)
+		gotBody = string(buf[:n])
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "text/plain", nil)
+	req.BodyReader = strings.NewReader("streamed payload")
+
+	if _, err := req.DumpRequest(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := req.Size(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotBody != "streamed payload" {
+		t.Errorf("body after DumpRequest/Size+Send = %q, want %q", gotBody, "streamed payload")
+	}
+}
+
+func TestResponseSize(t *testing.T) {
+	res := &requests.Response{
+		StatusCode: 200,
+		Headers:    map[string]string{"content-type": "text/plain"},
+		Body:       []byte("hello"),
+	}
+
+	size, err := res.Size()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size <= int64(len("hello")) {
+		t.Errorf("size = %d, want more than just the body length", size)
+	}
+}
+
+func TestResponseDump(t *testing.T) {
+	res := &requests.Response{
+		StatusCode: 200,
+		Headers:    map[string]string{"content-type": "text/plain"},
+		Body:       []byte("hello"),
+	}
+
+	dump, err := res.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(dump, []byte("HTTP/1.1 200 OK\r\n")) {
+		t.Errorf("dump doesn't start with status line, got %q", dump)
+	}
+	if !bytes.Contains(dump, []byte("Content-Type: text/plain")) {
+		t.Errorf("dump missing canonicalized header, got %q", dump)
+	}
+	if !bytes.HasSuffix(dump, []byte("hello")) {
+		t.Errorf("dump missing body, got %q", dump)
+	}
+}
@@ -0,0 +1,26 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendMeasuresDuration(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	res, err := requests.NewGetRequest(ts.URL).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Duration < 20*time.Millisecond {
+		t.Errorf("Duration = %v, want at least 20ms", res.Duration)
+	}
+}
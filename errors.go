@@ -0,0 +1,143 @@
+package requests
+
+import (
+	"errors"
+	"net"
+)
+
+// ErrDNS is returned (wrapped, with the hostname included) when a
+// request fails because the host couldn't be resolved, so callers can
+// distinguish "host not found" from timeouts or connection refused
+// without string-matching the underlying error.
+var ErrDNS = errors.New("requests: DNS resolution failed")
+
+// ErrBodyNotSeekable is returned by Send when a retry is needed but
+// BodyReader doesn't implement io.Seeker, so the body can't be
+// replayed for a second attempt.
+var ErrBodyNotSeekable = errors.New("requests: cannot retry request with a non-seekable BodyReader")
+
+// ErrCompressionRejected is returned by Send when CompressBody was
+// set, the server responded 415 Unsupported Media Type, and the
+// uncompressed fallback attempt also failed.
+var ErrCompressionRejected = errors.New("requests: server rejected gzip-compressed request body")
+
+// ErrCertificatePinMismatch is returned (wrapped, during the TLS
+// handshake) when a Client's PinnedCertSHA256 is set and the server's
+// leaf certificate doesn't match any of the pinned fingerprints.
+var ErrCertificatePinMismatch = errors.New("requests: server certificate doesn't match any pinned fingerprint")
+
+// ErrTimeout is returned (wrapped) when a request fails because it
+// exceeded its Timeout (or a context deadline), so callers can
+// distinguish a slow server from other connection failures without
+// string-matching the underlying error.
+var ErrTimeout = errors.New("requests: request timed out")
+
+// ErrConnection is returned (wrapped) when a request fails to
+// establish a connection at all (e.g. connection refused or a dial
+// failure other than DNS or a timeout).
+var ErrConnection = errors.New("requests: connection failed")
+
+// timeoutError wraps a timeout-flavored net.Error so that both
+// errors.Is(err, ErrTimeout) and errors.As(err, &netErr) work against
+// the result.
+type timeoutError struct {
+	err error
+}
+
+func (e *timeoutError) Error() string {
+	return "requests: request timed out: " + e.err.Error()
+}
+
+func (e *timeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+func (e *timeoutError) Unwrap() error {
+	return e.err
+}
+
+// connError wraps a dial failure so that errors.Is(err, ErrConnection)
+// works against the result.
+type connError struct {
+	err error
+}
+
+func (e *connError) Error() string {
+	return "requests: connection failed: " + e.err.Error()
+}
+
+func (e *connError) Is(target error) bool {
+	return target == ErrConnection
+}
+
+func (e *connError) Unwrap() error {
+	return e.err
+}
+
+// wrapClientError inspects err (as returned from http.Client.Do) and
+// wraps it as ErrDNS, ErrTimeout, or ErrConnection when it recognizes
+// the underlying cause, so callers can use errors.Is/errors.As instead
+// of matching on error strings. Unrecognized errors are returned
+// unchanged.
+func wrapClientError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if wrapped := wrapDNSError(err); wrapped != err {
+		return wrapped
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return &timeoutError{err: err}
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return &connError{err: err}
+	}
+	return err
+}
+
+// HTTPError is returned by Send when Request.RaiseOnError is set and
+// the response isn't Ok (status >= 400, or whatever Request.OkFunc
+// decided). It carries the response's status and body so callers
+// don't need to hold onto the *Response separately just to inspect a
+// failure.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	return "requests: unexpected response status: " + e.Status
+}
+
+// dnsError wraps a *net.DNSError so that both errors.Is(err, ErrDNS)
+// and errors.As(err, &dnsErr) work against the result.
+type dnsError struct {
+	host string
+	err  error
+}
+
+func (e *dnsError) Error() string {
+	return "requests: DNS resolution failed for " + e.host + ": " + e.err.Error()
+}
+
+func (e *dnsError) Is(target error) bool {
+	return target == ErrDNS
+}
+
+func (e *dnsError) Unwrap() error {
+	return e.err
+}
+
+// wrapDNSError checks err for an underlying *net.DNSError and, if
+// found, wraps it as ErrDNS with the hostname included. Otherwise it
+// returns err unchanged.
+func wrapDNSError(err error) error {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return &dnsError{host: dnsErr.Name, err: err}
+	}
+	return err
+}
@@ -0,0 +1,19 @@
+package requests_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendDNSErrorIsWrapped(t *testing.T) {
+	req := requests.NewGetRequest("http://this-host-definitely-does-not-exist.invalid")
+	_, err := req.Send()
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !errors.Is(err, requests.ErrDNS) {
+		t.Errorf("err = %v, want errors.Is(err, requests.ErrDNS)", err)
+	}
+}
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"io"
+	"os"
+)
+
+// SetBodyFile sets the request body to stream from f, setting
+// ContentLength from the file's size and content-type via GuessMIME
+// on its name (unless the caller already set one). This handles the
+// common "PUT this file to a URL" case in one call, with a correct
+// length and type instead of buffering the whole file into memory.
+func (req *Request) SetBodyFile(f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := req.GetHeader("content-type"); !ok {
+		req.SetHeader("content-type", GuessMIMEWithDefault(f.Name(), MIMEDefaultBinary))
+	}
+
+	req.BodyReader = f
+	req.ContentLength = info.Size()
+	return nil
+}
+
+// SaveToFile writes res.Body to the named file, creating it (or
+// truncating it if it already exists) with mode 0644. Pairs well
+// with GuessMIME for picking a name from the response's content-type.
+func (res *Response) SaveToFile(path string) error {
+	return os.WriteFile(path, res.Body, 0644)
+}
+
+// SaveStreamToFile copies body to the named file, creating it (or
+// truncating it if it already exists) with mode 0644. This is the
+// streaming counterpart to Response.SaveToFile, for a body obtained
+// from SendStream instead of Send.
+func SaveStreamToFile(body io.Reader, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, body)
+	return err
+}
@@ -0,0 +1,102 @@
+package requests_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetBodyFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+	if err := os.WriteFile(path, []byte(`{"a":1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var gotBody []byte
+	var gotContentType string
+	var gotContentLength int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotContentLength = r.ContentLength
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.Method = requests.PUT
+	if err := req.SetBodyFile(f); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(gotBody) != `{"a":1}` {
+		t.Errorf("body = %q, want %q", gotBody, `{"a":1}`)
+	}
+	if gotContentType != "application/json" {
+		t.Errorf("content-type = %q, want %q", gotContentType, "application/json")
+	}
+	if gotContentLength != 7 {
+		t.Errorf("content-length = %d, want 7", gotContentLength)
+	}
+}
+
+func TestResponseSaveToFile(t *testing.T) {
+	res := &requests.Response{Body: []byte("hello world")}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+	if err := res.SaveToFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello world" {
+		t.Errorf("file contents = %q, want %q", got, "hello world")
+	}
+}
+
+func TestSaveStreamToFile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("streamed content"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	body, _, err := req.SendStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "stream.txt")
+	if err := requests.SaveStreamToFile(body, path); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "streamed content" {
+		t.Errorf("file contents = %q, want %q", got, "streamed content")
+	}
+}
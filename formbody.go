@@ -0,0 +1,16 @@
+package requests
+
+import "net/url"
+
+// SetForm encodes values as application/x-www-form-urlencoded, sets
+// it as Body, and sets the content-type header accordingly - for
+// classic HTML form endpoints that expect a URL-encoded body instead
+// of JSON.
+func (req *Request) SetForm(values map[string]string) {
+	v := make(url.Values, len(values))
+	for k, val := range values {
+		v.Set(k, val)
+	}
+	req.Body = []byte(v.Encode())
+	req.SetHeader("content-type", "application/x-www-form-urlencoded")
+}
@@ -0,0 +1,39 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetForm(t *testing.T) {
+	var gotName, gotEmail, gotContentType string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("ParseForm: %v", err)
+			return
+		}
+		gotName = r.PostForm.Get("name")
+		gotEmail = r.PostForm.Get("email")
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "", nil)
+	req.SetForm(map[string]string{"name": "Ada Lovelace", "email": "ada@example.com"})
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotContentType != "application/x-www-form-urlencoded" {
+		t.Errorf("content-type = %q, want %q", gotContentType, "application/x-www-form-urlencoded")
+	}
+	if gotName != "Ada Lovelace" {
+		t.Errorf("name = %q, want %q", gotName, "Ada Lovelace")
+	}
+	if gotEmail != "ada@example.com" {
+		t.Errorf("email = %q, want %q", gotEmail, "ada@example.com")
+	}
+}
@@ -0,0 +1,22 @@
+package requests
+
+import "encoding/json"
+
+// GetJSON sends a GET request to url and decodes the JSON response
+// body into a value of type T, returning both the decoded value and
+// the Response for status inspection. This gives type-safe,
+// boilerplate-free consumption of typed API responses without
+// callers hand-rolling json.Unmarshal at every call site.
+func GetJSON[T any](url string) (T, *Response, error) {
+	var v T
+
+	res, err := NewGetRequest(url).Send()
+	if err != nil {
+		return v, nil, err
+	}
+
+	if err := json.Unmarshal(res.Body, &v); err != nil {
+		return v, res, err
+	}
+	return v, res, nil
+}
@@ -0,0 +1,33 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestGetJSON(t *testing.T) {
+	type person struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{"name":"alice","age":30}`))
+	}))
+	defer ts.Close()
+
+	p, res, err := requests.GetJSON[person](ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "alice" || p.Age != 30 {
+		t.Errorf("p = %+v, want {alice 30}", p)
+	}
+	if res.StatusCode != 200 {
+		t.Errorf("StatusCode = %d, want 200", res.StatusCode)
+	}
+}
@@ -0,0 +1,58 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SetJSON marshals v and assigns it to Body, setting the content-type
+// header to "application/json". Unlike SetJSONField it replaces the
+// body outright, so v can be any JSON-marshallable value - a struct,
+// slice, or map - not just an incremental field update.
+func (req *Request) SetJSON(v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("requests: SetJSON: %w", err)
+	}
+	req.Body = body
+	req.SetHeader("content-type", "application/json")
+	return nil
+}
+
+// MustSetJSON calls SetJSON and panics if it returns an error.
+func (req *Request) MustSetJSON(v interface{}) {
+	if err := req.SetJSON(v); err != nil {
+		panic(err)
+	}
+}
+
+// SetJSONField incrementally builds a JSON object request body,
+// setting key to value and re-marshalling. An empty Body starts a
+// fresh object; a non-empty Body is decoded first so repeated calls
+// accumulate fields instead of clobbering each other. It returns an
+// error if the existing Body isn't a JSON object, or if value can't
+// be marshalled.
+//
+// It also sets the content-type header to "application/json" unless
+// the caller has already set their own.
+func (req *Request) SetJSONField(key string, value interface{}) error {
+	fields := map[string]interface{}{}
+	if len(req.Body) > 0 {
+		if err := json.Unmarshal(req.Body, &fields); err != nil {
+			return fmt.Errorf("requests: SetJSONField: existing body isn't a JSON object: %w", err)
+		}
+	}
+
+	fields[key] = value
+
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("requests: SetJSONField: %w", err)
+	}
+	req.Body = body
+
+	if _, ok := req.GetHeader("content-type"); !ok {
+		req.SetHeader("content-type", "application/json")
+	}
+	return nil
+}
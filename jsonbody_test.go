@@ -0,0 +1,99 @@
+package requests_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetJSONMarshalsStruct(t *testing.T) {
+	type person struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	req := &requests.Request{}
+	if err := req.SetJSON(person{Name: "bob", Age: 40}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got person
+	if err := json.Unmarshal(req.Body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "bob" || got.Age != 40 {
+		t.Errorf("got = %+v, want {bob 40}", got)
+	}
+	if ct, ok := req.GetHeader("content-type"); !ok || ct != "application/json" {
+		t.Errorf("content-type = %q, %v, want %q, true", ct, ok, "application/json")
+	}
+}
+
+func TestSetJSONMarshalsSlice(t *testing.T) {
+	req := &requests.Request{}
+	if err := req.SetJSON([]int{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if string(req.Body) != "[1,2,3]" {
+		t.Errorf("body = %q, want %q", req.Body, "[1,2,3]")
+	}
+}
+
+func TestMustSetJSONPanicsOnUnmarshallableValue(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected MustSetJSON to panic on an unmarshallable value")
+		}
+	}()
+
+	req := &requests.Request{}
+	req.MustSetJSON(func() {})
+}
+
+func TestSetJSONFieldBuildsUpObject(t *testing.T) {
+	req := &requests.Request{}
+
+	if err := req.SetJSONField("name", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := req.SetJSONField("age", 30); err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(req.Body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["name"] != "alice" {
+		t.Errorf("name = %v, want %q", got["name"], "alice")
+	}
+	if got["age"] != float64(30) {
+		t.Errorf("age = %v, want 30", got["age"])
+	}
+
+	if ct, ok := req.GetHeader("content-type"); !ok || ct != "application/json" {
+		t.Errorf("content-type = %q, %v, want %q, true", ct, ok, "application/json")
+	}
+}
+
+func TestSetJSONFieldOverwritesExistingKey(t *testing.T) {
+	req := &requests.Request{}
+	req.SetJSONField("count", 1)
+	req.SetJSONField("count", 2)
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(req.Body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["count"] != float64(2) {
+		t.Errorf("count = %v, want 2", got["count"])
+	}
+}
+
+func TestSetJSONFieldRejectsNonObjectBody(t *testing.T) {
+	req := &requests.Request{Body: []byte(`[1,2,3]`)}
+	if err := req.SetJSONField("x", 1); err == nil {
+		t.Error("expected an error for a non-object existing body")
+	}
+}
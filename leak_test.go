@@ -0,0 +1,39 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendTimeoutDoesNotLeakGoroutines(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("too slow"))
+	}))
+	defer ts.Close()
+
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 50; i++ {
+		req := requests.NewGetRequest(ts.URL)
+		req.Timeout = time.Millisecond
+		if _, err := req.Send(); err == nil {
+			t.Fatal("expected a timeout error")
+		}
+	}
+
+	// Give any lingering goroutines a moment to actually wind down
+	// before comparing counts.
+	time.Sleep(100 * time.Millisecond)
+	runtime.GC()
+
+	after := runtime.NumGoroutine()
+	if after > before+5 {
+		t.Errorf("goroutine count grew from %d to %d after 50 timed-out requests", before, after)
+	}
+}
@@ -0,0 +1,94 @@
+package requests
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Links parses the response body as HTML and returns every href/src
+// attribute value found, resolved against the page's base URL - the
+// document's <base href>, if present, otherwise the Response's
+// FinalURL.
+//
+// Parsing with golang.org/x/net/html (rather than a regex over the
+// raw body) means attributes inside <script> bodies and HTML comments
+// are never mistaken for real links.
+//
+// It only attempts parsing when the response's content-type indicates
+// HTML; otherwise it returns an empty slice and a nil error.
+func (res *Response) Links() ([]string, error) {
+	if !res.IsHTML() {
+		return nil, nil
+	}
+
+	var base *url.URL
+	if res.FinalURL != "" {
+		b, err := url.Parse(res.FinalURL)
+		if err != nil {
+			return nil, err
+		}
+		base = b
+	}
+
+	doc, err := html.Parse(bytes.NewReader(res.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	var links []string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			if n.Data == "base" {
+				if href, ok := findAttr(n, "href"); ok {
+					if b, err := url.Parse(href); err == nil {
+						if base != nil {
+							b = base.ResolveReference(b)
+						}
+						base = b
+					}
+				}
+			} else {
+				for _, attr := range n.Attr {
+					if attr.Key != "href" && attr.Key != "src" {
+						continue
+					}
+					u, err := url.Parse(attr.Val)
+					if err != nil {
+						continue
+					}
+					if base != nil {
+						u = base.ResolveReference(u)
+					}
+					links = append(links, u.String())
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	return links, nil
+}
+
+// findAttr returns the value of n's attribute named key, if present.
+func findAttr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+// IsHTML reports whether the response's content-type header indicates
+// an HTML body.
+func (res *Response) IsHTML() bool {
+	ct, _ := res.GetHeader("content-type")
+	return strings.Contains(strings.ToLower(ct), "text/html")
+}
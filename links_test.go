@@ -0,0 +1,91 @@
+package requests_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseLinks(t *testing.T) {
+	res := requests.Response{
+		Headers: map[string]string{
+			"content-type": "text/html; charset=utf-8",
+		},
+		Body:     []byte(`<a href="/about">About</a><img src="logo.png"><a href="https://other.com/x">X</a>`),
+		FinalURL: "https://example.com/dir/page.html",
+	}
+
+	links, err := res.Links()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{
+		"https://example.com/about",
+		"https://example.com/dir/logo.png",
+		"https://other.com/x",
+	}
+	if !reflect.DeepEqual(links, expect) {
+		t.Errorf("Links() = %v, want %v", links, expect)
+	}
+}
+
+func TestResponseLinksBaseHref(t *testing.T) {
+	res := requests.Response{
+		Headers: map[string]string{
+			"content-type": "text/html; charset=utf-8",
+		},
+		Body:     []byte(`<base href="https://cdn.example.com/assets/"><a href="logo.png">Logo</a>`),
+		FinalURL: "https://example.com/dir/page.html",
+	}
+
+	links, err := res.Links()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{"https://cdn.example.com/assets/logo.png"}
+	if !reflect.DeepEqual(links, expect) {
+		t.Errorf("Links() = %v, want %v", links, expect)
+	}
+}
+
+func TestResponseLinksIgnoresScriptAndComments(t *testing.T) {
+	res := requests.Response{
+		Headers: map[string]string{
+			"content-type": "text/html; charset=utf-8",
+		},
+		Body: []byte(`<!-- <a href="/commented-out">nope</a> -->
+<script>var html = '<a href="/from-script">nope</a>';</script>
+<a href="/real">real</a>`),
+		FinalURL: "https://example.com/",
+	}
+
+	links, err := res.Links()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{"https://example.com/real"}
+	if !reflect.DeepEqual(links, expect) {
+		t.Errorf("Links() = %v, want %v", links, expect)
+	}
+}
+
+func TestResponseLinksNonHTML(t *testing.T) {
+	res := requests.Response{
+		Headers: map[string]string{
+			"content-type": "application/json",
+		},
+		Body: []byte(`{"href": "/ignored"}`),
+	}
+
+	links, err := res.Links()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(links) != 0 {
+		t.Errorf("expected no links for non-HTML response, got %v", links)
+	}
+}
@@ -1,8 +1,10 @@
 package requests
 
 import (
+	"net/http"
 	"path/filepath"
 	"strings"
+	"sync"
 )
 
 // Default MIME types for unknown text or
@@ -130,10 +132,32 @@ var MIMETypes = map[string]string{
 // on if the file is binary or not.
 func GuessMIME(filename string) (string, bool) {
 	ext := strings.ToLower(filepath.Ext(filename))
+	mimeMu.RLock()
+	defer mimeMu.RUnlock()
 	mime, ok := MIMETypes[ext]
 	return mime, ok
 }
 
+// mimeMu guards MIMETypes against concurrent writes from
+// RegisterMIMEType racing with reads from GuessMIME. It doesn't
+// protect against code that writes to MIMETypes directly instead of
+// going through RegisterMIMEType.
+var mimeMu sync.RWMutex
+
+// RegisterMIMEType adds ext (with or without its leading period) to
+// MIMETypes under a write lock, so registering project-specific
+// extensions like ".parquet" or ".ndjson" from multiple goroutines
+// doesn't race with GuessMIME's reads.
+func RegisterMIMEType(ext, mimeType string) {
+	ext = strings.ToLower(ext)
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	mimeMu.Lock()
+	defer mimeMu.Unlock()
+	MIMETypes[ext] = mimeType
+}
+
 // GuessMIMEWithDefault is a helper function for guessing the MIME type
 // using GuessMIME but returning `defaultMIME` if the MIME type can't
 // be guessed.
@@ -146,3 +170,22 @@ func GuessMIMEWithDefault(filename string, defaultMIME string) string {
 	}
 	return mime
 }
+
+// SniffMIME guesses a MIME type from data's content rather than a
+// filename, using http.DetectContentType on its first 512 bytes. It
+// always returns a usable MIME type, falling back to
+// "application/octet-stream" when nothing more specific is detected.
+func SniffMIME(data []byte) string {
+	return http.DetectContentType(data)
+}
+
+// GuessMIMEFromContent guesses a MIME type for filename, trying the
+// extension first via GuessMIME and falling back to SniffMIME on
+// data's content if the extension is missing or unrecognized. Useful
+// for uploads where the filename may be extension-less or mislabeled.
+func GuessMIMEFromContent(filename string, data []byte) string {
+	if mime, ok := GuessMIME(filename); ok {
+		return mime
+	}
+	return SniffMIME(data)
+}
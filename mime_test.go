@@ -69,6 +69,59 @@ func TestGuessMIME(t *testing.T) {
 	}
 }
 
+func TestRegisterMIMEType(t *testing.T) {
+	requests.RegisterMIMEType(".parquet", "application/vnd.apache.parquet")
+	requests.RegisterMIMEType("ndjson", "application/x-ndjson")
+
+	m, ok := requests.GuessMIME("data.parquet")
+	if !ok || m != "application/vnd.apache.parquet" {
+		t.Errorf("GuessMIME(%q) = (%q, %v), want (%q, true)", "data.parquet", m, ok, "application/vnd.apache.parquet")
+	}
+
+	m, ok = requests.GuessMIME("log.ndjson")
+	if !ok || m != "application/x-ndjson" {
+		t.Errorf("GuessMIME(%q) = (%q, %v), want (%q, true)", "log.ndjson", m, ok, "application/x-ndjson")
+	}
+}
+
+func TestRegisterMIMETypeConcurrentWithGuessMIME(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			requests.RegisterMIMEType(".concurrent", "application/x-concurrent")
+		}
+		close(done)
+	}()
+	for i := 0; i < 1000; i++ {
+		requests.GuessMIME("file.concurrent")
+	}
+	<-done
+}
+
+func TestSniffMIME(t *testing.T) {
+	m := requests.SniffMIME([]byte("<!DOCTYPE html><html><body>hi</body></html>"))
+	if m != "text/html; charset=utf-8" {
+		t.Errorf("SniffMIME(html) = %q, want %q", m, "text/html; charset=utf-8")
+	}
+
+	m = requests.SniffMIME([]byte(`{"a":1}`))
+	if m != "text/plain; charset=utf-8" {
+		t.Errorf("SniffMIME(json bytes) = %q, want %q", m, "text/plain; charset=utf-8")
+	}
+}
+
+func TestGuessMIMEFromContent(t *testing.T) {
+	m := requests.GuessMIMEFromContent("report.pdf", []byte("not actually a pdf"))
+	if m != "application/pdf" {
+		t.Errorf("GuessMIMEFromContent with known extension = %q, want %q", m, "application/pdf")
+	}
+
+	m = requests.GuessMIMEFromContent("noext", []byte("<!DOCTYPE html><html></html>"))
+	if m != "text/html; charset=utf-8" {
+		t.Errorf("GuessMIMEFromContent without extension = %q, want %q", m, "text/html; charset=utf-8")
+	}
+}
+
 func TestGuessMIMEWithDefault(t *testing.T) {
 	in := "foo.foo"
 	expect := "application/octet-stream"
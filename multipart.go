@@ -0,0 +1,73 @@
+package requests
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SetMultipartForm builds a multipart/form-data body from fields,
+// following the curl "-F" convention: a value prefixed with "@" is
+// treated as a path to a file, which is read and attached with its
+// content type guessed from the file's extension via GuessMIME.
+// Any other value becomes a plain text field.
+//
+// On success it sets req.Body and the content-type header to the
+// generated multipart boundary.
+func (req *Request) SetMultipartForm(fields map[string]string) error {
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+
+	for name, val := range fields {
+		if !strings.HasPrefix(val, "@") {
+			if err := w.WriteField(name, val); err != nil {
+				return err
+			}
+			continue
+		}
+
+		path := val[1:]
+		if err := writeMultipartFile(w, name, path); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	req.Body = buf.Bytes()
+	req.SetHeader("content-type", w.FormDataContentType())
+	return nil
+}
+
+// writeMultipartFile opens the file at path and attaches it to w as
+// a form file part under the given field name, using GuessMIME to set
+// its content type.
+func writeMultipartFile(w *multipart.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	filename := filepath.Base(path)
+	mimeType := GuessMIMEWithDefault(filename, MIMEDefaultBinary)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, name, filename))
+	header.Set("Content-Type", mimeType)
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, f)
+	return err
+}
@@ -0,0 +1,81 @@
+package requests_test
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetMultipartForm(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "upload.txt")
+	if err := os.WriteFile(path, []byte("file contents"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &requests.Request{}
+	err := req.SetMultipartForm(map[string]string{
+		"name": "ping",
+		"file": "@" + path,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ct, ok := req.GetHeader("content-type")
+	if !ok {
+		t.Fatal("content-type header not set")
+	}
+
+	_, params, err := mime.ParseMediaType(ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mr := multipart.NewReader(bytes.NewReader(req.Body), params["boundary"])
+
+	sawField, sawFile := false, false
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch part.FormName() {
+		case "name":
+			sawField = true
+			data, _ := io.ReadAll(part)
+			if string(data) != "ping" {
+				t.Errorf("name field = %q, want %q", string(data), "ping")
+			}
+		case "file":
+			sawFile = true
+			if part.FileName() != "upload.txt" {
+				t.Errorf("filename = %q, want %q", part.FileName(), "upload.txt")
+			}
+			if part.Header.Get("Content-Type") != "text/plain" {
+				t.Errorf("content-type = %q, want %q", part.Header.Get("Content-Type"), "text/plain")
+			}
+			data, _ := io.ReadAll(part)
+			if string(data) != "file contents" {
+				t.Errorf("file contents = %q, want %q", string(data), "file contents")
+			}
+		}
+	}
+
+	if !sawField {
+		t.Error("expected to see the \"name\" field")
+	}
+	if !sawFile {
+		t.Error("expected to see the \"file\" field")
+	}
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// SetNDJSONBody sets the request body to stream items as
+// newline-delimited JSON (application/x-ndjson), marshalling one
+// item at a time rather than buffering the whole payload in memory.
+// items must be a slice or a channel of values that json.Marshal can
+// encode.
+//
+// It sets BodyReader to the streaming pipe and the content-type
+// header to "application/x-ndjson".
+func (req *Request) SetNDJSONBody(items interface{}) error {
+	rv := reflect.ValueOf(items)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Chan:
+	default:
+		return fmt.Errorf("requests: SetNDJSONBody requires a slice or channel, got %s", rv.Kind())
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		enc := json.NewEncoder(pw)
+
+		var err error
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < rv.Len() && err == nil; i++ {
+				err = enc.Encode(rv.Index(i).Interface())
+			}
+		case reflect.Chan:
+			for {
+				v, ok := rv.Recv()
+				if !ok {
+					break
+				}
+				if err = enc.Encode(v.Interface()); err != nil {
+					break
+				}
+			}
+		}
+
+		pw.CloseWithError(err)
+	}()
+
+	req.BodyReader = pr
+	req.SetHeader("content-type", "application/x-ndjson")
+	return nil
+}
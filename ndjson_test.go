@@ -0,0 +1,81 @@
+package requests_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetNDJSONBodyFromSlice(t *testing.T) {
+	var gotLines []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+			t.Errorf("content-type = %q, want application/x-ndjson", ct)
+		}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			gotLines = append(gotLines, scanner.Text())
+		}
+	}))
+	defer ts.Close()
+
+	req := &requests.Request{
+		Method: requests.POST,
+		URL:    ts.URL,
+	}
+	items := []map[string]int{{"n": 1}, {"n": 2}, {"n": 3}}
+	if err := req.SetNDJSONBody(items); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(gotLines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(gotLines))
+	}
+	for i, line := range gotLines {
+		var m map[string]int
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m["n"] != i+1 {
+			t.Errorf("line %d: n = %d, want %d", i, m["n"], i+1)
+		}
+	}
+}
+
+func TestSetNDJSONBodyFromChannel(t *testing.T) {
+	var gotLines []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			gotLines = append(gotLines, scanner.Text())
+		}
+	}))
+	defer ts.Close()
+
+	ch := make(chan map[string]int, 2)
+	ch <- map[string]int{"n": 1}
+	ch <- map[string]int{"n": 2}
+	close(ch)
+
+	req := &requests.Request{
+		Method: requests.POST,
+		URL:    ts.URL,
+	}
+	if err := req.SetNDJSONBody(ch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(gotLines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(gotLines))
+	}
+}
@@ -0,0 +1,40 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestParseHTTPMethod(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out requests.HTTPMethod
+	}{
+		{"GET", requests.GET},
+		{"post", requests.POST},
+		{"Put", requests.PUT},
+		{"DELETE", requests.DELETE},
+		{"options", requests.OPTIONS},
+		{"HEAD", requests.HEAD},
+		{"connect", requests.CONNECT},
+		{"TRACE", requests.TRACE},
+		{"patch", requests.PATCH},
+	}
+
+	for _, tc := range testCases {
+		m, err := requests.ParseHTTPMethod(tc.in)
+		if err != nil {
+			t.Errorf("ParseHTTPMethod(%q) returned error: %v", tc.in, err)
+		}
+		if m != tc.out {
+			t.Errorf("ParseHTTPMethod(%q) = %v, want %v", tc.in, m, tc.out)
+		}
+	}
+}
+
+func TestParseHTTPMethodUnknown(t *testing.T) {
+	if _, err := requests.ParseHTTPMethod("FOOBAR"); err == nil {
+		t.Error("expected an error for an unknown method")
+	}
+}
@@ -0,0 +1,34 @@
+package requests
+
+import "io"
+
+// progressReader wraps r, calling onProgress after every Read with
+// the cumulative number of bytes read so far and the response's
+// total size (or -1 if unknown).
+type progressReader struct {
+	r          io.Reader
+	total      int64
+	read       int64
+	onProgress func(bytesRead, total int64)
+}
+
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.r.Read(buf)
+	if n > 0 {
+		p.read += int64(n)
+		p.onProgress(p.read, p.total)
+	}
+	return n, err
+}
+
+// progressReadCloser pairs a progressReader with the Close method of
+// the stream it wraps, for use where the caller needs an
+// io.ReadCloser (e.g. SendStream) rather than a plain io.Reader.
+type progressReadCloser struct {
+	*progressReader
+	closer io.Closer
+}
+
+func (p *progressReadCloser) Close() error {
+	return p.closer.Close()
+}
@@ -0,0 +1,68 @@
+package requests_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendOnProgress(t *testing.T) {
+	payload := strings.Repeat("x", 1000)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(payload))
+	}))
+	defer ts.Close()
+
+	var lastRead, lastTotal int64
+	var calls int
+	req := requests.NewGetRequest(ts.URL)
+	req.OnProgress = func(bytesRead, total int64) {
+		calls++
+		lastRead = bytesRead
+		lastTotal = total
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if calls == 0 {
+		t.Fatal("expected OnProgress to be called at least once")
+	}
+	if lastRead != int64(len(payload)) {
+		t.Errorf("final bytesRead = %d, want %d", lastRead, len(payload))
+	}
+	if lastTotal != int64(len(payload)) {
+		t.Errorf("total = %d, want %d", lastTotal, len(payload))
+	}
+}
+
+func TestSendStreamOnProgress(t *testing.T) {
+	payload := strings.Repeat("y", 1000)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(payload))
+	}))
+	defer ts.Close()
+
+	var lastRead int64
+	req := requests.NewGetRequest(ts.URL)
+	req.OnProgress = func(bytesRead, total int64) {
+		lastRead = bytesRead
+	}
+
+	body, _, err := req.SendStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	if _, err := io.ReadAll(body); err != nil {
+		t.Fatal(err)
+	}
+	if lastRead != int64(len(payload)) {
+		t.Errorf("final bytesRead = %d, want %d", lastRead, len(payload))
+	}
+}
@@ -0,0 +1,41 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestRequestProxy(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		w.Write([]byte("via proxy"))
+	}))
+	defer proxy.Close()
+
+	req := requests.NewGetRequest("http://example.invalid/")
+	req.Proxy = proxy.URL
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proxied {
+		t.Error("expected the request to go through the proxy")
+	}
+	if string(res.Body) != "via proxy" {
+		t.Errorf("body = %q, want %q", res.Body, "via proxy")
+	}
+}
+
+func TestRequestProxyInvalidURL(t *testing.T) {
+	req := requests.NewGetRequest("http://example.invalid/")
+	req.Proxy = "://not-a-url"
+
+	if _, err := req.Send(); err == nil {
+		t.Fatal("expected an error for an invalid Proxy URL")
+	}
+}
@@ -0,0 +1,73 @@
+package requests_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendRaiseOnErrorReturnsHTTPError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.RaiseOnError = true
+	res, err := req.Send()
+	if res == nil {
+		t.Fatal("expected a non-nil Response even though an error was returned")
+	}
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, want %d", res.StatusCode, http.StatusNotFound)
+	}
+
+	var httpErr *requests.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("err = %v, want *requests.HTTPError", err)
+	}
+	if httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("HTTPError.StatusCode = %d, want %d", httpErr.StatusCode, http.StatusNotFound)
+	}
+	if string(httpErr.Body) != "not found" {
+		t.Errorf("HTTPError.Body = %q, want %q", httpErr.Body, "not found")
+	}
+}
+
+func TestSendRaiseOnErrorIgnoredWhenOk(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.RaiseOnError = true
+	res, err := req.Send()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(res.Body) != "ok" {
+		t.Errorf("Body = %q, want %q", res.Body, "ok")
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	res := &requests.Response{Ok: true}
+	if err := res.Error(); err != nil {
+		t.Errorf("Error() = %v, want nil for an Ok response", err)
+	}
+
+	res = &requests.Response{Ok: false, StatusCode: 500, Body: []byte("boom")}
+	err := res.Error()
+	if err == nil {
+		t.Fatal("expected a non-nil error for a non-Ok response")
+	}
+	var httpErr *requests.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != 500 {
+		t.Errorf("Error() = %v, want *HTTPError with StatusCode 500", err)
+	}
+}
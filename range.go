@@ -0,0 +1,23 @@
+package requests
+
+import "fmt"
+
+// SetRange sets the Range header to request bytes start through end
+// (inclusive), for resuming interrupted downloads or fetching a
+// file in chunks. A negative end means an open-ended range (e.g.
+// "bytes=500-" for everything from byte 500 onward).
+func (req *Request) SetRange(start, end int64) {
+	if end < 0 {
+		req.SetHeader("Range", fmt.Sprintf("bytes=%d-", start))
+		return
+	}
+	req.SetHeader("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+}
+
+// AcceptsRanges reports whether the server advertised support for
+// range requests via the Accept-Ranges header, so a caller can check
+// before relying on SetRange for a resumable download.
+func (res *Response) AcceptsRanges() bool {
+	v, ok := res.GetHeader("accept-ranges")
+	return ok && v != "none"
+}
@@ -0,0 +1,40 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetRange(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetRange(0, 499)
+	if v, ok := req.GetHeader("range"); !ok || v != "bytes=0-499" {
+		t.Errorf("Range = %q, %v, want %q, true", v, ok, "bytes=0-499")
+	}
+}
+
+func TestSetRangeOpenEnded(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetRange(500, -1)
+	if v, ok := req.GetHeader("range"); !ok || v != "bytes=500-" {
+		t.Errorf("Range = %q, %v, want %q, true", v, ok, "bytes=500-")
+	}
+}
+
+func TestResponseAcceptsRanges(t *testing.T) {
+	res := &requests.Response{Headers: map[string]string{"accept-ranges": "bytes"}}
+	if !res.AcceptsRanges() {
+		t.Error("expected AcceptsRanges() to be true")
+	}
+
+	res = &requests.Response{Headers: map[string]string{"accept-ranges": "none"}}
+	if res.AcceptsRanges() {
+		t.Error("expected AcceptsRanges() to be false for \"none\"")
+	}
+
+	res = &requests.Response{}
+	if res.AcceptsRanges() {
+		t.Error("expected AcceptsRanges() to be false when header is absent")
+	}
+}
@@ -0,0 +1,59 @@
+package requests_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+type countingLimiter struct {
+	calls int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.calls, 1)
+	return nil
+}
+
+func TestSendWaitsOnRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	limiter := &countingLimiter{}
+	req := requests.NewGetRequest(ts.URL)
+	req.RateLimit = limiter
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&limiter.calls) != 1 {
+		t.Errorf("limiter.calls = %d, want 1", limiter.calls)
+	}
+}
+
+type erroringLimiter struct{}
+
+func (erroringLimiter) Wait(ctx context.Context) error {
+	return errors.New("rate limit context exceeded")
+}
+
+func TestSendFailsWhenRateLimitErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.RateLimit = erroringLimiter{}
+
+	if _, err := req.Send(); err == nil {
+		t.Fatal("expected an error when the rate limiter errors")
+	}
+}
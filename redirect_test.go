@@ -0,0 +1,105 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendFollowsRedirectsByDefault(t *testing.T) {
+	var finalHit bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/start" {
+			http.Redirect(w, r, "/end", http.StatusFound)
+			return
+		}
+		finalHit = true
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL + "/start")
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !finalHit {
+		t.Error("expected the redirect to be followed to /end")
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", res.StatusCode)
+	}
+}
+
+func TestSendDisableRedirectsReturnsRedirectResponse(t *testing.T) {
+	var finalHit bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/start" {
+			http.Redirect(w, r, "/end", http.StatusFound)
+			return
+		}
+		finalHit = true
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL + "/start")
+	req.DisableRedirects = true
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if finalHit {
+		t.Error("expected the redirect NOT to be followed")
+	}
+	if res.StatusCode != http.StatusFound {
+		t.Errorf("status = %d, want 302", res.StatusCode)
+	}
+	loc, ok := res.GetHeader("location")
+	if !ok || loc != "/end" {
+		t.Errorf("location = %q, ok = %v, want %q", loc, ok, "/end")
+	}
+}
+
+func TestSendFinalURLReflectsRedirectDestination(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/start" {
+			http.Redirect(w, r, "/end", http.StatusFound)
+			return
+		}
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL + "/start")
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ts.URL + "/end"
+	if res.FinalURL != want {
+		t.Errorf("FinalURL = %q, want %q", res.FinalURL, want)
+	}
+}
+
+func TestSendMaxRedirectsCapsFollowing(t *testing.T) {
+	var hits int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		http.Redirect(w, r, "/next", http.StatusFound)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.MaxRedirects = 2
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusFound {
+		t.Errorf("status = %d, want 302", res.StatusCode)
+	}
+	// 1 initial request + 2 followed redirects = 3 hits
+	if hits != 3 {
+		t.Errorf("hits = %d, want 3", hits)
+	}
+}
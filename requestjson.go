@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// requestJSON mirrors the subset of Request's fields that round-trip
+// cleanly through JSON - everything else (func-typed hooks, Conn,
+// Client, and the like) can't be serialized and is dropped.
+type requestJSON struct {
+	URL     string            `json:"url"`
+	Method  HTTPMethod        `json:"method"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Query   map[string]string `json:"query,omitempty"`
+	Body    []byte            `json:"body,omitempty"`
+	Timeout time.Duration     `json:"timeout,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, emitting Method as a string
+// (e.g. "POST") and Body as base64, so a Request can be persisted as
+// a fixture and replayed later. Request's func-typed fields (OkFunc,
+// RetryOnStatus, and the like) and anything else that can't round-
+// trip through JSON are silently dropped.
+func (req *Request) MarshalJSON() ([]byte, error) {
+	return json.Marshal(requestJSON{
+		URL:     req.URL,
+		Method:  req.Method,
+		Headers: req.Headers,
+		Query:   req.Query,
+		Body:    req.Body,
+		Timeout: req.Timeout,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, the inverse of
+// MarshalJSON.
+func (req *Request) UnmarshalJSON(data []byte) error {
+	var aux requestJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	req.URL = aux.URL
+	req.Method = aux.Method
+	req.Headers = aux.Headers
+	req.Query = aux.Query
+	req.Body = aux.Body
+	req.Timeout = aux.Timeout
+	return nil
+}
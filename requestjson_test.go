@@ -0,0 +1,67 @@
+package requests_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestRequestMarshalJSON(t *testing.T) {
+	req := requests.NewPostRequest("http://example.com/api", "application/json", []byte(`{"a":1}`))
+	req.SetQuery("page", "2")
+	req.Timeout = 5 * time.Second
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["method"] != "POST" {
+		t.Errorf("method = %v, want %q", got["method"], "POST")
+	}
+	if got["url"] != "http://example.com/api" {
+		t.Errorf("url = %v, want %q", got["url"], "http://example.com/api")
+	}
+}
+
+func TestRequestMarshalUnmarshalJSONRoundTrip(t *testing.T) {
+	req := requests.NewPostRequest("http://example.com/api", "application/json", []byte(`{"a":1}`))
+	req.SetQuery("page", "2")
+	req.Timeout = 5 * time.Second
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got requests.Request
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.URL != req.URL {
+		t.Errorf("URL = %q, want %q", got.URL, req.URL)
+	}
+	if got.Method != req.Method {
+		t.Errorf("Method = %v, want %v", got.Method, req.Method)
+	}
+	if string(got.Body) != string(req.Body) {
+		t.Errorf("Body = %q, want %q", got.Body, req.Body)
+	}
+	if got.Timeout != req.Timeout {
+		t.Errorf("Timeout = %v, want %v", got.Timeout, req.Timeout)
+	}
+	ct, ok := got.GetHeader("content-type")
+	if !ok || ct != "application/json" {
+		t.Errorf("content-type = %q, %v, want %q, true", ct, ok, "application/json")
+	}
+	if v, ok := got.GetQuery("page"); !ok || v != "2" {
+		t.Errorf("page = %q, %v, want %q, true", v, ok, "2")
+	}
+}
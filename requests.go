@@ -2,11 +2,17 @@ package requests
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -36,6 +42,24 @@ func JSONMust(data map[string]interface{}) []byte {
 	return res
 }
 
+// RateLimiter is consulted by Send before every attempt (including
+// the first) when set as a Request's RateLimit. It's satisfied
+// directly by *golang.org/x/time/rate.Limiter, so callers can plug in
+// a real token-bucket limiter without this package depending on it.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenSource supplies a bearer token for Send to attach as the
+// Authorization header before every attempt, so long-running services
+// can refresh an expiring OAuth2 token in one place instead of
+// reacting to 401s everywhere they call this package. Adapt
+// *golang.org/x/oauth2.TokenSource with a one-line wrapper that calls
+// Token().AccessToken - this package doesn't depend on it directly.
+type TokenSource interface {
+	Token() (string, error)
+}
+
 // HTTPMethod is a type that represents an
 // HTTP request method.
 // Read more here: https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods
@@ -54,6 +78,19 @@ const (
 	PATCH                     // An HTTP PATCH method
 )
 
+// IsIdempotent reports whether the method is considered idempotent per
+// RFC 7231 - i.e. making the same request multiple times has the same
+// effect as making it once. GET, HEAD, PUT, DELETE, OPTIONS, and TRACE
+// are idempotent; POST and PATCH generally are not.
+func (m HTTPMethod) IsIdempotent() bool {
+	switch m {
+	case GET, HEAD, PUT, DELETE, OPTIONS, TRACE:
+		return true
+	default:
+		return false
+	}
+}
+
 // Convert an HTTPMethod to it's string format
 func (m HTTPMethod) String() string {
 	switch m {
@@ -79,6 +116,72 @@ func (m HTTPMethod) String() string {
 	return ""
 }
 
+// ParseHTTPMethod parses a method name (case-insensitive) into an
+// HTTPMethod, returning an error if it doesn't match a known method.
+func ParseHTTPMethod(s string) (HTTPMethod, error) {
+	switch strings.ToUpper(s) {
+	case "GET":
+		return GET, nil
+	case "POST":
+		return POST, nil
+	case "PUT":
+		return PUT, nil
+	case "DELETE":
+		return DELETE, nil
+	case "OPTIONS":
+		return OPTIONS, nil
+	case "HEAD":
+		return HEAD, nil
+	case "CONNECT":
+		return CONNECT, nil
+	case "TRACE":
+		return TRACE, nil
+	case "PATCH":
+		return PATCH, nil
+	}
+	return 0, fmt.Errorf("requests: unknown HTTP method %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler, serializing m as its
+// name (e.g. "GET") instead of its underlying int, so it round-trips
+// cleanly through formats that use MarshalText/UnmarshalText and stays
+// robust against the enum being reordered.
+func (m HTTPMethod) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing text via
+// ParseHTTPMethod.
+func (m *HTTPMethod) UnmarshalText(text []byte) error {
+	parsed, err := ParseHTTPMethod(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, serializing m as its name
+// (e.g. "GET") instead of its underlying int.
+func (m HTTPMethod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string via
+// ParseHTTPMethod.
+func (m *HTTPMethod) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseHTTPMethod(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
 // Request is a type that represents an HTTP request
 //
 // Notes:
@@ -91,6 +194,177 @@ type Request struct {
 	Query   map[string]string // Query parameters to send with the request
 	Body    []byte            // Body to send with the request
 	Timeout time.Duration     // Timeout for the request
+
+	// AutoDecompress, when true, sniffs the response body's leading
+	// bytes for gzip/zlib magic numbers and decompresses it regardless
+	// of the Content-Encoding header. It's off by default since it's
+	// a heuristic meant to rescue interop with misconfigured servers.
+	AutoDecompress bool
+
+	// MaxDecompressedSize bounds the size of a body decompressed via
+	// AutoDecompress. Exceeding it fails the request with
+	// ErrDecompressedTooLarge instead of expanding without limit.
+	// Zero means unlimited.
+	MaxDecompressedSize int64
+
+	// BodyReader, when set, is used as the request body instead of
+	// Body, and is passed through to the underlying http.Request
+	// without being buffered into memory first.
+	BodyReader io.Reader
+
+	// MaxRetries is the number of additional attempts Send makes
+	// after a failed attempt, waiting RetryBackoff between each.
+	// Zero (the default) disables retries.
+	MaxRetries int
+
+	// RetryBackoff is the delay between retry attempts.
+	RetryBackoff time.Duration
+
+	// Breaker, if set, is consulted before every attempt (including
+	// the first). If it reports the circuit open, Send fails
+	// immediately with ErrCircuitOpen rather than sleeping through a
+	// retry backoff into a service that's known to be down.
+	Breaker *CircuitBreaker
+
+	// QueryValues holds additional, possibly-repeated query params
+	// merged into the URL alongside Query. Use it for keys that need
+	// multiple values (e.g. ?tag=a&tag=b), which the single-valued
+	// Query map can't represent.
+	QueryValues map[string][]string
+
+	// HeaderValues holds additional, possibly-repeated headers sent
+	// alongside Headers. Use it for headers that need multiple values
+	// (e.g. repeated Link or Cookie headers), which the single-valued
+	// Headers map can't represent.
+	HeaderValues map[string][]string
+
+	// Conn, if set, is used instead of a pooled http.Client connection:
+	// the request is written directly to it and the response read
+	// back from it. This unlocks tunneling scenarios (e.g. sending a
+	// request over a connection obtained via CONNECT) and low-level
+	// proxy testing that the usual always-new-client path can't
+	// support.
+	Conn net.Conn
+
+	// TeeBody, if set, receives a copy of the response body as it's
+	// read, alongside the usual buffering into Response.Body. This
+	// avoids reading the body twice when you need both, e.g. for an
+	// audit log that must record the exact bytes received.
+	TeeBody io.Writer
+
+	// ContentLength, if nonzero, is set on the underlying http.Request
+	// explicitly. It's needed for body sources (like an *os.File via
+	// SetBodyFile) that net/http can't size automatically from their
+	// type.
+	ContentLength int64
+
+	// OkFunc, if set, overrides how Response.Ok is computed for this
+	// request: it's called with the response status code and its
+	// result becomes Ok, instead of the default "status < 400". This
+	// takes precedence over a Client's OkFunc - see Client.Do.
+	OkFunc func(int) bool
+
+	// RetryOnStatus, if set, is called with a successful response's
+	// status code to decide whether Send should treat it as a failure
+	// worth retrying (e.g. a 503 from an overloaded upstream), rather
+	// than returning it. It has no effect once MaxRetries attempts are
+	// exhausted, at which point the last such response is returned.
+	// Before each such retry, Send waits for the response's Retry-After
+	// header if present, falling back to RetryBackoff otherwise.
+	RetryOnStatus func(int) bool
+
+	// RaiseOnError, when true, makes Send/SendWithContext return a
+	// *HTTPError (from Response.Error) alongside the *Response whenever
+	// the response isn't Ok (status >= 400), instead of a nil error.
+	// The *Response is still returned and populated as usual, so
+	// callers that want the body of a failed request can read it off
+	// the returned Response even though err is non-nil.
+	RaiseOnError bool
+
+	// CompressBody gzip-compresses the request body before sending
+	// and sets Content-Encoding: gzip. If the server responds with
+	// 415 Unsupported Media Type, Send retries once with compression
+	// disabled and returns that result instead, so enabling this is
+	// safe even against servers that don't support it.
+	CompressBody bool
+
+	// Client, if set, is used to send the request instead of the
+	// throwaway http.Client Send otherwise builds from Timeout and
+	// transport. Set this to share a pooled client (and its connection
+	// reuse, custom Transport, or proxy config) across many requests.
+	Client *http.Client
+
+	// DisableRedirects, when true, stops the throwaway client from
+	// following 3xx responses: the redirect response itself is
+	// returned as the Response, Location header and all, instead of
+	// being followed. Has no effect if Client is set - a
+	// caller-supplied client's own CheckRedirect is left alone.
+	DisableRedirects bool
+
+	// MaxRedirects caps the number of redirects the throwaway client
+	// will follow before giving up. Zero (the default) leaves the
+	// underlying http.Client's own limit (10) in place. Has no effect
+	// if DisableRedirects is true, or if Client is set.
+	MaxRedirects int
+
+	// OnProgress, if set, is called after every chunk read from the
+	// response body - in Send and SendStream alike - with the
+	// cumulative bytes read so far and the total size taken from the
+	// response's Content-Length, or -1 if the server didn't send one.
+	OnProgress func(bytesRead, total int64)
+
+	// Proxy, if set, routes the request through the given proxy URL
+	// (e.g. "http://proxy.example.com:8080" or
+	// "socks5://proxy.example.com:1080") instead of whatever the
+	// environment's HTTP_PROXY/HTTPS_PROXY variables specify. Has no
+	// effect if Client is set.
+	Proxy string
+
+	// TLSConfig, if set, is used to build a dedicated http.Transport
+	// for this request, taking precedence over whatever transport
+	// Send would otherwise use - including one threaded in by
+	// Client.Do. Use it to reach a dev cluster with a self-signed
+	// cert (a custom RootCAs pool) or, as a last resort,
+	// InsecureSkipVerify - which disables certificate validation
+	// entirely and must never be set outside of tests against hosts
+	// you control. Has no effect if Client is set.
+	TLSConfig *tls.Config
+
+	// RateLimit, if set, is waited on before every attempt (including
+	// the first), so Send naturally backs off instead of hammering a
+	// rate-limited API into a 429 ban. Satisfied directly by
+	// *golang.org/x/time/rate.Limiter, without this package depending
+	// on it.
+	RateLimit RateLimiter
+
+	// TokenSource, if set, is asked for a fresh token before every
+	// attempt (including retries), which Send sets as a Bearer
+	// authorization header - unless the request already has an
+	// explicit authorization header, which always wins.
+	TokenSource TokenSource
+
+	// SignRequest, if set, is called with the fully-built *http.Request
+	// - method, URL, headers, and body all in place - right before it's
+	// handed to the client, so it can compute an HMAC-style signature
+	// (AWS SigV4 and similar) over the canonical request and add
+	// whatever headers the target API requires. Returning an error
+	// fails the Send attempt before any network call is made.
+	SignRequest func(*http.Request) error
+
+	// ctx, if set, is used as the base context for the underlying
+	// request instead of context.Background(), so the caller
+	// (SendWithContext, SendAsync) can cancel an in-flight request.
+	ctx context.Context
+
+	// trace, if set, is attached to the underlying request's context
+	// so the caller (currently only Client.Do) can observe connection
+	// reuse via httptrace.
+	trace *httptrace.ClientTrace
+
+	// transport, if set, is used in place of the zero-value
+	// http.Transport, so the caller (currently only Client.Do) can
+	// apply transport-level settings like a minimum TLS version.
+	transport http.RoundTripper
 }
 
 // NewGetRequest creates a new Request object
@@ -122,6 +396,55 @@ func NewPostRequest(url string, contentType string, body []byte) *Request {
 	}
 }
 
+// NewPutRequest creates a new Request object with the supplied URL,
+// content-type header, and body, and sets the HTTP method to PUT.
+func NewPutRequest(url string, contentType string, body []byte) *Request {
+	return &Request{
+		URL:     url,
+		Method:  PUT,
+		Headers: map[string]string{"content-type": contentType},
+		Body:    body,
+	}
+}
+
+// NewPatchRequest creates a new Request object with the supplied URL,
+// content-type header, and body, and sets the HTTP method to PATCH.
+func NewPatchRequest(url string, contentType string, body []byte) *Request {
+	return &Request{
+		URL:     url,
+		Method:  PATCH,
+		Headers: map[string]string{"content-type": contentType},
+		Body:    body,
+	}
+}
+
+// NewDeleteRequest creates a new Request object with the supplied URL
+// and sets the HTTP method to DELETE.
+func NewDeleteRequest(url string) *Request {
+	return &Request{
+		URL:    url,
+		Method: DELETE,
+	}
+}
+
+// NewHeadRequest creates a new Request object with the supplied URL
+// and sets the HTTP method to HEAD. A HEAD response carries no body,
+// so it's useful for checking Content-Length, Last-Modified, or mere
+// existence without downloading the body.
+func NewHeadRequest(url string) *Request {
+	return &Request{
+		URL:    url,
+		Method: HEAD,
+	}
+}
+
+// SendHeadRequest creates a new HTTP HEAD request and sends it to the
+// specified URL.
+// Internally, calls `NewHeadRequest(url).Send()`
+func SendHeadRequest(url string) (*Response, error) {
+	return NewHeadRequest(url).Send()
+}
+
 // SendPostRequest creates a new HTTP POST request
 // and sends it to the specified URL.
 // Internally, calls `NewPostRequest(url, contentType, body).Send()`
@@ -136,12 +459,68 @@ func SendPostJSONRequest(url string, body []byte) (*Response, error) {
 	return NewPostRequest(url, "application/json", body).Send()
 }
 
+// SendPutRequest creates a new HTTP PUT request and sends it to the
+// specified URL. Internally, calls `NewPutRequest(url, contentType, body).Send()`
+func SendPutRequest(url string, contentType string, body []byte) (*Response, error) {
+	return NewPutRequest(url, contentType, body).Send()
+}
+
+// SendPatchRequest creates a new HTTP PATCH request and sends it to
+// the specified URL. Internally, calls `NewPatchRequest(url, contentType, body).Send()`
+func SendPatchRequest(url string, contentType string, body []byte) (*Response, error) {
+	return NewPatchRequest(url, contentType, body).Send()
+}
+
+// SendDeleteRequest creates a new HTTP DELETE request and sends it to
+// the specified URL. Internally, calls `NewDeleteRequest(url).Send()`
+func SendDeleteRequest(url string) (*Response, error) {
+	return NewDeleteRequest(url).Send()
+}
+
+// SendJSON sends the request and decodes the JSON response body. If
+// the caller hasn't already set their own Accept header, it sets
+// Accept: application/json first, so the server returns the format
+// this call is about to parse instead of guessing.
+func (req *Request) SendJSON() (map[string]interface{}, error) {
+	if _, ok := req.GetHeader("accept"); !ok {
+		req.SetHeader("accept", "application/json")
+	}
+
+	res, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+	return res.JSON()
+}
+
 // Copy will create a copy of the Request object
 func (req *Request) Copy() *Request {
 	r := Request{
-		URL:     req.URL,
-		Method:  req.Method,
-		Timeout: req.Timeout,
+		URL:                 req.URL,
+		Method:              req.Method,
+		Timeout:             req.Timeout,
+		AutoDecompress:      req.AutoDecompress,
+		MaxDecompressedSize: req.MaxDecompressedSize,
+		CompressBody:        req.CompressBody,
+		OkFunc:              req.OkFunc,
+		ContentLength:       req.ContentLength,
+		DisableRedirects:    req.DisableRedirects,
+		MaxRedirects:        req.MaxRedirects,
+		OnProgress:          req.OnProgress,
+		TLSConfig:           req.TLSConfig,
+		Proxy:               req.Proxy,
+		RaiseOnError:        req.RaiseOnError,
+		RateLimit:           req.RateLimit,
+		TokenSource:         req.TokenSource,
+		SignRequest:         req.SignRequest,
+		BodyReader:          req.BodyReader,
+		MaxRetries:          req.MaxRetries,
+		RetryBackoff:        req.RetryBackoff,
+		Breaker:             req.Breaker,
+		RetryOnStatus:       req.RetryOnStatus,
+		Client:              req.Client,
+		Conn:                req.Conn,
+		TeeBody:             req.TeeBody,
 	}
 	if req.Headers != nil {
 		r.Headers = make(map[string]string)
@@ -155,6 +534,18 @@ func (req *Request) Copy() *Request {
 			r.Query[k] = v
 		}
 	}
+	if req.QueryValues != nil {
+		r.QueryValues = make(map[string][]string)
+		for k, v := range req.QueryValues {
+			r.QueryValues[k] = append([]string(nil), v...)
+		}
+	}
+	if req.HeaderValues != nil {
+		r.HeaderValues = make(map[string][]string)
+		for k, v := range req.HeaderValues {
+			r.HeaderValues[k] = append([]string(nil), v...)
+		}
+	}
 	if req.Body != nil {
 		r.Body = make([]byte, len(req.Body))
 		copy(r.Body, req.Body)
@@ -162,12 +553,56 @@ func (req *Request) Copy() *Request {
 	return &r
 }
 
-// getReqBody returns the request body as a buffer that can be
-// passed to the http.NewRequest function
-func (req *Request) getReqBody() *bytes.Buffer {
+// IsSafe reports whether it's safe to retry this request - i.e. its
+// method is idempotent, per HTTPMethod.IsIdempotent.
+func (req *Request) IsSafe() bool {
+	return req.Method.IsIdempotent()
+}
+
+// getReqBody returns the request body as a reader that can be
+// passed to the http.NewRequest function. If BodyReader is set, it's
+// used directly and streamed without buffering Body into memory. It
+// returns nil when there's no body at all (BodyReader unset and Body
+// empty), so requests like a plain GET don't end up with an attached
+// zero-length body and a spurious Content-Length: 0.
+func (req *Request) getReqBody() io.Reader {
+	if req.BodyReader != nil {
+		return req.BodyReader
+	}
+	if len(req.Body) == 0 {
+		return nil
+	}
 	return bytes.NewBuffer(req.Body)
 }
 
+// peekReqBody reads the entire request body for debugging helpers
+// (ToCurl, DumpRequest, Size) without permanently draining
+// req.BodyReader the way a plain io.ReadAll(req.getReqBody()) would:
+// if it's seekable it's rewound to the start afterward, mirroring the
+// retry path's seek-and-resend; otherwise it's buffered into memory
+// and replaced with a fresh reader over the same bytes, so a real
+// Send afterward still sees the full payload instead of an empty one.
+func (req *Request) peekReqBody() ([]byte, error) {
+	body := req.getReqBody()
+	if body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if req.BodyReader != nil {
+		if seeker, ok := req.BodyReader.(io.Seeker); ok {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("requests: failed to seek BodyReader: %w", err)
+			}
+		} else {
+			req.BodyReader = bytes.NewReader(data)
+		}
+	}
+	return data, nil
+}
+
 // getURL returns the string formatted URL with
 // the query parameters
 func (req *Request) getURL() (string, error) {
@@ -182,13 +617,22 @@ func (req *Request) getURL() (string, error) {
 		return "", err
 	}
 
-	// Encode the query parameters (if any)
-	vals := url.Values{}
+	// Merge Query/QueryValues into whatever query params the URL
+	// already had, with Query/QueryValues winning on conflicts - a key
+	// present in both replaces the URL's existing value(s) rather than
+	// appending to them. The URL's fragment (if any) is untouched, so
+	// u.String() carries it through unchanged.
+	vals := u.Query()
 	for k, v := range req.Query {
 		vals.Set(k, v)
 	}
-	q := vals.Encode()
-	u.RawQuery = q
+	for k, vs := range req.QueryValues {
+		vals.Del(k)
+		for _, v := range vs {
+			vals.Add(k, v)
+		}
+	}
+	u.RawQuery = vals.Encode()
 
 	return u.String(), nil
 }
@@ -272,6 +716,36 @@ func (req *Request) SetHeader(name, value string) {
 	req.Headers[key] = value
 }
 
+// AddHeader appends value to the set of values for name, allowing the
+// same header to be sent more than once (e.g. repeated Cookie
+// headers), rather than replacing whatever SetHeader set.
+func (req *Request) AddHeader(name, value string) {
+	if req.HeaderValues == nil {
+		req.HeaderValues = make(map[string][]string)
+	}
+	key := strings.ToLower(name)
+	req.HeaderValues[key] = append(req.HeaderValues[key], value)
+}
+
+// GetHeaders returns every value set for name, combining the
+// single-valued Headers entry (if any) with whatever was added via
+// AddHeader, and whether any value was found at all. GetHeader remains
+// the way to fetch just the first value.
+func (req *Request) GetHeaders(name string) ([]string, bool) {
+	key := strings.ToLower(name)
+
+	var values []string
+	for k, v := range req.Headers {
+		if strings.ToLower(k) == key {
+			values = append(values, v)
+			break
+		}
+	}
+	values = append(values, req.HeaderValues[key]...)
+
+	return values, len(values) > 0
+}
+
 // DelHeader deletes a header value from the request headers
 // if it exists. Normalizes the key to lowercase
 // before deleting.
@@ -315,6 +789,69 @@ func (req *Request) SetQuery(name, value string) {
 	req.Query[name] = value
 }
 
+// SetQueryInt formats v with strconv and stores it as a query param,
+// saving the caller an explicit strconv.Itoa at the call site.
+func (req *Request) SetQueryInt(name string, v int) {
+	req.SetQuery(name, strconv.Itoa(v))
+}
+
+// SetQueryBool formats v as "true" or "false" and stores it as a
+// query param, saving the caller an explicit strconv.FormatBool at
+// the call site.
+func (req *Request) SetQueryBool(name string, v bool) {
+	req.SetQuery(name, strconv.FormatBool(v))
+}
+
+// SetQueryFloat formats v with strconv.FormatFloat (using the
+// shortest representation that round-trips) and stores it as a query
+// param, saving the caller an explicit conversion at the call site.
+func (req *Request) SetQueryFloat(name string, v float64) {
+	req.SetQuery(name, strconv.FormatFloat(v, 'g', -1, 64))
+}
+
+// SetQueryFromValues ingests every key/value pair from v (e.g. from
+// url.Parse(someURL).Query()), replacing whatever Query or
+// QueryValues previously held for each key it touches. Keys with a
+// single value land in Query; keys with more than one land in
+// QueryValues, so multi-valued params like repeated ?tag=a&tag=b
+// aren't lost.
+func (req *Request) SetQueryFromValues(v url.Values) {
+	for k, vs := range v {
+		if len(vs) == 1 {
+			req.SetQuery(k, vs[0])
+			continue
+		}
+		if req.QueryValues == nil {
+			req.QueryValues = make(map[string][]string)
+		}
+		req.QueryValues[k] = append([]string(nil), vs...)
+	}
+}
+
+// GetQueryValues returns every value set for name, combining the
+// single-valued Query entry (if any) with whatever was added via
+// AddQuery. GetQuery remains the way to fetch just the single value
+// set via SetQuery.
+func (req *Request) GetQueryValues(name string) []string {
+	var values []string
+	if v, ok := req.Query[name]; ok {
+		values = append(values, v)
+	}
+	values = append(values, req.QueryValues[name]...)
+	return values
+}
+
+// SetPriority sets the HTTP/2 Priority header (RFC 9218) for the
+// request, expressing the given urgency (0, most urgent, to 7, least
+// urgent) and whether the response is incremental.
+func (req *Request) SetPriority(urgency int, incremental bool) {
+	val := fmt.Sprintf("u=%d", urgency)
+	if incremental {
+		val += ", i"
+	}
+	req.SetHeader("Priority", val)
+}
+
 // DelQuery deletes a query value from the request headers
 // if it exists.
 func (req *Request) DelQuery(name string) {
@@ -327,11 +864,179 @@ func (req *Request) DelQuery(name string) {
 	delete(req.Query, name)
 }
 
-// Send sends the HTTP request with the supplied parameters
+// Send sends the HTTP request with the supplied parameters. If
+// MaxRetries is set, it retries on failure (waiting RetryBackoff
+// between attempts), checking Breaker before each attempt.
 func (req *Request) Send() (*Response, error) {
-	// Create an http client (with optional timeout)
-	client := http.Client{
-		Timeout: req.Timeout,
+	return req.SendWithContext(context.Background())
+}
+
+// SendWithContext sends the HTTP request the same way Send does, but
+// ties the underlying http.Request to ctx so that a caller can cancel
+// an in-flight request or propagate a deadline from a parent operation.
+// If ctx is canceled mid-download, the returned error wraps ctx.Err()
+// rather than returning a partial Response.
+func (req *Request) SendWithContext(ctx context.Context) (*Response, error) {
+	req.ctx = ctx
+
+	attempts := req.MaxRetries + 1
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	var lastRes *Response
+	for attempt := 0; attempt < attempts; attempt++ {
+		if req.Breaker != nil && !req.Breaker.Allow() {
+			return nil, ErrCircuitOpen
+		}
+
+		if req.RateLimit != nil {
+			if err := req.RateLimit.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("requests: rate limiter: %w", err)
+			}
+		}
+
+		if attempt > 0 && req.BodyReader != nil {
+			seeker, ok := req.BodyReader.(io.Seeker)
+			if !ok {
+				return nil, fmt.Errorf("requests: %w", ErrBodyNotSeekable)
+			}
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("requests: failed to seek BodyReader for retry: %w", err)
+			}
+		}
+
+		res, err := req.sendOnce()
+		if err == nil {
+			if req.CompressBody && res.StatusCode == http.StatusUnsupportedMediaType {
+				fallback := req.Copy()
+				fallback.CompressBody = false
+				fbRes, fbErr := fallback.sendOnce()
+				if fbErr != nil {
+					return res, fmt.Errorf("requests: %w", ErrCompressionRejected)
+				}
+				if req.RaiseOnError {
+					return fbRes, fbRes.Error()
+				}
+				return fbRes, nil
+			}
+
+			if req.RetryOnStatus == nil || !req.RetryOnStatus(res.StatusCode) {
+				if req.Breaker != nil {
+					req.Breaker.RecordSuccess()
+				}
+				if req.RaiseOnError {
+					return res, res.Error()
+				}
+				return res, nil
+			}
+
+			lastRes = res
+			lastErr = fmt.Errorf("requests: received retryable status code %d", res.StatusCode)
+			if req.Breaker != nil {
+				req.Breaker.RecordFailure()
+			}
+			if attempt < attempts-1 {
+				backoff := req.RetryBackoff
+				if d, ok := res.RetryAfter(); ok {
+					backoff = d
+				}
+				time.Sleep(backoff)
+			}
+			continue
+		}
+
+		lastErr = err
+		lastRes = nil
+		if req.Breaker != nil {
+			req.Breaker.RecordFailure()
+		}
+		if attempt < attempts-1 {
+			time.Sleep(req.RetryBackoff)
+		}
+	}
+
+	if attempts == 1 {
+		if lastRes != nil {
+			return lastRes, nil
+		}
+		return nil, lastErr
+	}
+	if lastRes != nil {
+		return lastRes, fmt.Errorf("requests: request failed after %d attempt(s): %w", attempts, lastErr)
+	}
+	return nil, fmt.Errorf("requests: request failed after %d attempt(s): %w", attempts, lastErr)
+}
+
+// buildTransport returns a dedicated *http.Transport for req.Proxy
+// and/or req.TLSConfig, or nil if neither is set - in which case the
+// caller should fall back to its usual transport. If req.TLSConfig
+// isn't set but req.transport already carries a TLSClientConfig (e.g.
+// a Client's MinTLSVersion/PinnedCertSHA256, threaded in by
+// Client.Do), that config is carried over so a per-request Proxy
+// doesn't silently drop certificate pinning.
+func (req *Request) buildTransport() (http.RoundTripper, error) {
+	if req.Proxy == "" && req.TLSConfig == nil {
+		return nil, nil
+	}
+	tlsConfig := req.TLSConfig
+	if tlsConfig == nil {
+		if rt, ok := req.transport.(*http.Transport); ok && rt != nil {
+			tlsConfig = rt.TLSClientConfig
+		}
+	}
+	t := &http.Transport{TLSClientConfig: tlsConfig}
+	if req.Proxy != "" {
+		proxyURL, err := url.Parse(req.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("requests: invalid Proxy URL: %w", err)
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+	return t, nil
+}
+
+// sendOnce performs a single attempt at sending the request.
+func (req *Request) sendOnce() (*Response, error) {
+	if req.Conn != nil {
+		return req.sendOverConn()
+	}
+
+	// Use the caller-supplied client if set, otherwise build a
+	// throwaway one from Timeout (and any transport threaded in by
+	// Client.Do).
+	timeout := req.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	client := req.Client
+	if client == nil {
+		client = &http.Client{
+			Timeout:   timeout,
+			Transport: req.transport,
+		}
+		t, err := req.buildTransport()
+		if err != nil {
+			return nil, err
+		}
+		if t != nil {
+			client.Transport = t
+		}
+		if req.DisableRedirects {
+			client.CheckRedirect = func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			}
+		} else if req.MaxRedirects > 0 {
+			maxRedirects := req.MaxRedirects
+			client.CheckRedirect = func(_ *http.Request, via []*http.Request) error {
+				if len(via) > maxRedirects {
+					return http.ErrUseLastResponse
+				}
+				return nil
+			}
+		}
 	}
 
 	// Format the URL with the query parameters (if any)
@@ -341,44 +1046,131 @@ func (req *Request) Send() (*Response, error) {
 	}
 
 	// Create the underlying request
-	httpRequest, err := http.NewRequest(req.Method.String(), u, req.getReqBody())
+	ctx := req.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if req.trace != nil {
+		ctx = httptrace.WithClientTrace(ctx, req.trace)
+	}
+	reqBody := req.getReqBody()
+	compressed := false
+	if req.CompressBody && reqBody != nil {
+		c, err := compressGzip(reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("error compressing request body: %w", err)
+		}
+		reqBody = c
+		compressed = true
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, req.Method.String(), u, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
+	if req.ContentLength != 0 {
+		httpRequest.ContentLength = req.ContentLength
+	}
 
-	// Set the headers in the underlying request
+	// Set the headers in the underlying request, package defaults
+	// first so a per-request header of the same name wins.
+	for k, v := range DefaultHeaders {
+		httpRequest.Header.Set(k, v)
+	}
 	for k, v := range req.Headers {
 		httpRequest.Header.Set(k, v)
 	}
+	for k, vs := range req.HeaderValues {
+		for _, v := range vs {
+			httpRequest.Header.Add(k, v)
+		}
+	}
+	if httpRequest.Header.Get("User-Agent") == "" {
+		httpRequest.Header.Set("User-Agent", DefaultUserAgent)
+	}
+	if req.TokenSource != nil && httpRequest.Header.Get("Authorization") == "" {
+		token, err := req.TokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("requests: token source: %w", err)
+		}
+		httpRequest.Header.Set("Authorization", "Bearer "+token)
+	}
+	if compressed {
+		httpRequest.Header.Set("Content-Encoding", "gzip")
+	}
+	if req.SignRequest != nil {
+		if err := req.SignRequest(httpRequest); err != nil {
+			return nil, fmt.Errorf("requests: sign request: %w", err)
+		}
+	}
 
-	// Make the reuquest
+	// Make the reuquest, timing the round trip end-to-end
+	sendStart := time.Now()
 	httpResponse, err := client.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, wrapClientError(err)
 	}
 
 	// Add return headers
 	rHeaders := make(map[string]string)
+	rHeaderValues := make(map[string][]string)
 	for k, v := range httpResponse.Header {
 		if len(v) > 0 {
 			lowerKey := strings.ToLower(k)
 			rHeaders[lowerKey] = v[0]
+			rHeaderValues[lowerKey] = v
 		}
 	}
 
-	// Load the request body
+	// Load the request body, timing how long it takes to measure throughput
 	defer httpResponse.Body.Close()
-	body, err := ioutil.ReadAll(httpResponse.Body)
+	bodyReader := httpResponse.Body
+	if req.TeeBody != nil {
+		bodyReader = io.NopCloser(io.TeeReader(bodyReader, req.TeeBody))
+	}
+	if req.OnProgress != nil {
+		bodyReader = io.NopCloser(&progressReader{
+			r:          bodyReader,
+			total:      httpResponse.ContentLength,
+			onProgress: req.OnProgress,
+		})
+	}
+	readStart := time.Now()
+	body, err := ioutil.ReadAll(bodyReader)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("requests: %w", ctxErr)
+		}
 		return nil, err
 	}
+	readElapsed := time.Since(readStart)
+	duration := time.Since(sendStart)
+
+	// Sniff and decompress the body if requested, regardless of
+	// whether Content-Encoding correctly describes it.
+	if req.AutoDecompress {
+		body, err = sniffDecompress(body, req.MaxDecompressedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Format the response & return
+	ok := httpResponse.StatusCode < 400
+	if req.OkFunc != nil {
+		ok = req.OkFunc(httpResponse.StatusCode)
+	}
 	res := Response{
-		Ok:         httpResponse.StatusCode < 400,
-		StatusCode: httpResponse.StatusCode,
-		Headers:    rHeaders,
-		Body:       body,
+		Ok:           ok,
+		StatusCode:   httpResponse.StatusCode,
+		Headers:      rHeaders,
+		HeaderValues: rHeaderValues,
+		Body:         body,
+		FinalURL:     httpResponse.Request.URL.String(),
+		Duration:     duration,
+	}
+	if readElapsed > 0 {
+		res.BytesPerSecond = float64(len(body)) / readElapsed.Seconds()
 	}
 
 	return &res, nil
@@ -395,6 +1187,43 @@ func (req *Request) MustSend() *Response {
 	return res
 }
 
+// SendExpect sends the request and returns an error if the response's
+// status code isn't one of statusCodes, so monitoring scripts can
+// check a status in one call instead of sending, then comparing
+// res.StatusCode themselves.
+func (req *Request) SendExpect(statusCodes ...int) (*Response, error) {
+	res, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, code := range statusCodes {
+		if res.StatusCode == code {
+			return res, nil
+		}
+	}
+
+	return res, fmt.Errorf("requests: unexpected status code %d, expected one of %v", res.StatusCode, statusCodes)
+}
+
+// SendString sends the request and returns the response body as a
+// string along with the status code, collapsing the usual Response
+// handling for quick scripts that just want the text.
+func (req *Request) SendString() (string, int, error) {
+	res, err := req.Send()
+	if err != nil {
+		return "", 0, err
+	}
+	return string(res.Body), res.StatusCode, nil
+}
+
+// GetString sends an HTTP GET request to the given URL and returns the
+// response body as a string along with the status code.
+// Internally, calls `NewGetRequest(url).SendString()`
+func GetString(url string) (string, int, error) {
+	return NewGetRequest(url).SendString()
+}
+
 // Response is a type that represents an HTTP response
 // returned from an HTTP request
 type Response struct {
@@ -402,6 +1231,37 @@ type Response struct {
 	StatusCode int               // HTTP response status code
 	Headers    map[string]string // HTTP Response headers
 	Body       []byte            // HTTP Response body
+	FinalURL   string            // URL of the response, after following any redirects
+
+	// HeaderValues holds every value the server sent for each response
+	// header, lowercased, unlike Headers which only keeps the first.
+	// Use GetHeaders to read repeated headers like Set-Cookie or Vary.
+	HeaderValues map[string][]string
+
+	// BytesPerSecond is the average transfer rate of the response body,
+	// measured from just before the body is read to just after. It's
+	// 0 if the body was empty or the read was effectively instant.
+	BytesPerSecond float64
+
+	// Duration is how long the request took end-to-end, from just
+	// before the underlying client.Do call to just after the response
+	// body finished reading. Useful for logging slow upstreams and
+	// building latency histograms without instrumenting around Send
+	// yourself.
+	Duration time.Duration
+}
+
+// HTTPHeader reconstructs an http.Header from the response's
+// (lowercased, single-valued) Headers map, canonicalizing each key
+// with textproto.CanonicalMIMEHeaderKey. This smooths interop with
+// any stdlib-based code that expects an http.Header rather than a
+// plain map.
+func (res *Response) HTTPHeader() http.Header {
+	h := make(http.Header, len(res.Headers))
+	for k, v := range res.Headers {
+		h.Set(k, v)
+	}
+	return h
 }
 
 // GetHeader gets a header value from the response if it exists.
@@ -427,6 +1287,34 @@ func (res *Response) GetHeader(name string) (string, bool) {
 	return "", false
 }
 
+// GetHeaders returns every value the server sent for name (e.g. every
+// Set-Cookie line), backed by HeaderValues, and whether any were
+// found. GetHeader remains the way to fetch just the first value.
+func (res *Response) GetHeaders(name string) ([]string, bool) {
+	key := strings.ToLower(name)
+	if res.HeaderValues == nil {
+		return nil, false
+	}
+	v, ok := res.HeaderValues[key]
+	return v, ok
+}
+
+// Error returns a *HTTPError describing this response if it's not Ok
+// (status >= 400, or whatever Request.OkFunc decided), or nil
+// otherwise. Send calls this automatically when Request.RaiseOnError
+// is set, but it's also useful on its own for callers who'd rather
+// check res.Error() than res.Ok.
+func (res *Response) Error() error {
+	if res.Ok {
+		return nil
+	}
+	return &HTTPError{
+		StatusCode: res.StatusCode,
+		Status:     fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		Body:       res.Body,
+	}
+}
+
 // JSON unmarshalls the response body into a map
 func (res *Response) JSON() (map[string]interface{}, error) {
 	if len(res.Body) == 0 {
@@ -444,3 +1332,33 @@ func (res *Response) JSON() (map[string]interface{}, error) {
 
 	return data, nil
 }
+
+// JSONInto unmarshals the response body into v, unlike JSON which
+// only decodes into a map[string]interface{}. v can be a pointer to
+// any type json.Unmarshal supports, including a struct or a slice,
+// so it also works when the top-level JSON is an array.
+func (res *Response) JSONInto(v interface{}) error {
+	return json.Unmarshal(res.Body, v)
+}
+
+// DecodeStrict decodes the response body's JSON into v, failing if
+// the body contains fields that v doesn't define. This catches schema
+// drift - an upstream adding or renaming fields - that a plain
+// json.Unmarshal would silently ignore.
+func (res *Response) DecodeStrict(v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(res.Body))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// MustJSON is the same as JSON except it panics if there is an error.
+// Mirroring JSONMust and MustSend, it's meant for throwaway scripts
+// and tests, not production code - those should call JSON and handle
+// the error.
+func (res *Response) MustJSON() map[string]interface{} {
+	data, err := res.JSON()
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -1,9 +1,12 @@
 package requests_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -89,6 +92,34 @@ func TestHTTPMethods(t *testing.T) {
 	}
 }
 
+func TestHTTPMethodIsIdempotent(t *testing.T) {
+	idempotent := []requests.HTTPMethod{requests.GET, requests.HEAD, requests.PUT, requests.DELETE, requests.OPTIONS, requests.TRACE}
+	for _, m := range idempotent {
+		if !m.IsIdempotent() {
+			t.Errorf("%s should be idempotent", m)
+		}
+	}
+
+	notIdempotent := []requests.HTTPMethod{requests.POST, requests.PATCH, requests.CONNECT}
+	for _, m := range notIdempotent {
+		if m.IsIdempotent() {
+			t.Errorf("%s should not be idempotent", m)
+		}
+	}
+}
+
+func TestRequestIsSafe(t *testing.T) {
+	r := &requests.Request{Method: requests.GET}
+	if !r.IsSafe() {
+		t.Error("GET request should be safe")
+	}
+
+	r = &requests.Request{Method: requests.POST}
+	if r.IsSafe() {
+		t.Error("POST request should not be safe")
+	}
+}
+
 func TestRequestHeaders(t *testing.T) {
 	r := &requests.Request{}
 	if r == nil {
@@ -111,6 +142,28 @@ func TestRequestHeaders(t *testing.T) {
 	}
 }
 
+func TestSetPriority(t *testing.T) {
+	r := &requests.Request{}
+
+	r.SetPriority(1, false)
+	p, ok := r.GetHeader("priority")
+	if !ok {
+		t.Fatal("priority header not set")
+	}
+	if p != "u=1" {
+		t.Errorf("priority = %q, want %q", p, "u=1")
+	}
+
+	r.SetPriority(5, true)
+	p, ok = r.GetHeader("priority")
+	if !ok {
+		t.Fatal("priority header not set")
+	}
+	if p != "u=5, i" {
+		t.Errorf("priority = %q, want %q", p, "u=5, i")
+	}
+}
+
 func TestSendGetRequest(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintln(w, "Hello, World!")
@@ -138,6 +191,325 @@ func TestSendGetRequest(t *testing.T) {
 
 }
 
+func TestGetString(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "Hello, World!")
+
+		if r.Method != "GET" {
+			t.Errorf("Request method is \"%s\" not GET", r.Method)
+		}
+
+	}))
+	defer ts.Close()
+
+	bod, code, err := requests.GetString(ts.URL)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if code != 200 {
+		t.Error("status code is not 200")
+	}
+
+	if bod != "Hello, World!\n" {
+		t.Error(fmt.Sprintf("response body is \"%s\" not Hello, World!", bod))
+	}
+}
+
+func TestSendExpect(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	if _, err := req.SendExpect(200, 204); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSendJSONSetsAcceptHeader(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "application/json" {
+			t.Errorf("Accept = %q, want %q", r.Header.Get("Accept"), "application/json")
+		}
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	data, err := req.SendJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data["ok"] != true {
+		t.Errorf("ok = %v, want true", data["ok"])
+	}
+}
+
+func TestSendJSONRespectsExplicitAccept(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "application/vnd.custom+json" {
+			t.Errorf("Accept = %q, want %q", r.Header.Get("Accept"), "application/vnd.custom+json")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetHeader("accept", "application/vnd.custom+json")
+	if _, err := req.SendJSON(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHTTPMethodJSONRoundTrip(t *testing.T) {
+	data, err := json.Marshal(requests.POST)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"POST"` {
+		t.Errorf("data = %s, want %q", data, `"POST"`)
+	}
+
+	var m requests.HTTPMethod
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m != requests.POST {
+		t.Errorf("m = %v, want POST", m)
+	}
+}
+
+func TestHTTPMethodUnmarshalJSONInvalid(t *testing.T) {
+	var m requests.HTTPMethod
+	if err := json.Unmarshal([]byte(`"BOGUS"`), &m); err == nil {
+		t.Error("expected an error for an unknown method")
+	}
+}
+
+func TestHTTPMethodTextRoundTrip(t *testing.T) {
+	text, err := requests.GET.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != "GET" {
+		t.Errorf("text = %s, want %q", text, "GET")
+	}
+
+	var m requests.HTTPMethod
+	if err := m.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if m != requests.GET {
+		t.Errorf("m = %v, want GET", m)
+	}
+}
+
+func TestSendTeeBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("audit me"))
+	}))
+	defer ts.Close()
+
+	var tee bytes.Buffer
+	req := requests.NewGetRequest(ts.URL)
+	req.TeeBody = &tee
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "audit me" {
+		t.Errorf("res.Body = %q, want %q", res.Body, "audit me")
+	}
+	if tee.String() != "audit me" {
+		t.Errorf("tee = %q, want %q", tee.String(), "audit me")
+	}
+}
+
+func TestRequestOkFunc(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.OkFunc = func(code int) bool {
+		return code == 404
+	}
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Ok {
+		t.Error("expected Ok = true for a 404 treated as success")
+	}
+}
+
+func TestRequestCustomClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("custom client"))
+	}))
+	defer ts.Close()
+
+	var dialed int
+	transport := &http.Transport{}
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed++
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+
+	req := requests.NewGetRequest(ts.URL)
+	req.Client = &http.Client{Transport: transport}
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "custom client" {
+		t.Errorf("body = %q, want %q", res.Body, "custom client")
+	}
+	if dialed != 1 {
+		t.Errorf("dialed = %d, want 1 (the custom client's transport wasn't used)", dialed)
+	}
+}
+
+func TestResponseJSONIntoStruct(t *testing.T) {
+	res := &requests.Response{Body: []byte(`{"name":"alice","age":30}`)}
+
+	var got struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+	if err := res.JSONInto(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "alice" || got.Age != 30 {
+		t.Errorf("got = %+v, want {alice 30}", got)
+	}
+}
+
+func TestResponseJSONIntoArray(t *testing.T) {
+	res := &requests.Response{Body: []byte(`[1,2,3]`)}
+
+	var got []int
+	if err := res.JSONInto(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("got = %v, want [1 2 3]", got)
+	}
+}
+
+func TestResponseDecodeStrict(t *testing.T) {
+	type person struct {
+		Name string `json:"name"`
+	}
+
+	res := &requests.Response{Body: []byte(`{"name":"alice"}`)}
+	var p person
+	if err := res.DecodeStrict(&p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "alice" {
+		t.Errorf("Name = %q, want %q", p.Name, "alice")
+	}
+}
+
+func TestResponseDecodeStrictUnknownField(t *testing.T) {
+	type person struct {
+		Name string `json:"name"`
+	}
+
+	res := &requests.Response{Body: []byte(`{"name":"alice","extra":1}`)}
+	var p person
+	if err := res.DecodeStrict(&p); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestRequestGetHeadersMultiValue(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Values("Cookie")
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetHeader("Cookie", "a=1")
+	req.AddHeader("Cookie", "b=2")
+
+	values, ok := req.GetHeaders("cookie")
+	if !ok {
+		t.Fatal("expected GetHeaders to find the Cookie header")
+	}
+	if len(values) != 2 || values[0] != "a=1" || values[1] != "b=2" {
+		t.Errorf("values = %v, want [a=1 b=2]", values)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != "a=1" || got[1] != "b=2" {
+		t.Errorf("server saw Cookie = %v, want [a=1 b=2]", got)
+	}
+}
+
+func TestResponseGetHeadersMultiValue(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Set-Cookie", "a=1")
+		w.Header().Add("Set-Cookie", "b=2")
+	}))
+	defer ts.Close()
+
+	res, err := requests.NewGetRequest(ts.URL).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, ok := res.GetHeaders("set-cookie")
+	if !ok {
+		t.Fatal("expected GetHeaders to find the Set-Cookie header")
+	}
+	if len(values) != 2 || values[0] != "a=1" || values[1] != "b=2" {
+		t.Errorf("values = %v, want [a=1 b=2]", values)
+	}
+
+	first, ok := res.GetHeader("set-cookie")
+	if !ok || first != "a=1" {
+		t.Errorf("GetHeader = %q, %v, want %q, true", first, ok, "a=1")
+	}
+}
+
+func TestResponseHTTPHeader(t *testing.T) {
+	res := &requests.Response{
+		Headers: map[string]string{"content-type": "application/json", "x-custom": "val"},
+	}
+
+	h := res.HTTPHeader()
+	if h.Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type = %q, want %q", h.Get("Content-Type"), "application/json")
+	}
+	if h.Get("X-Custom") != "val" {
+		t.Errorf("X-Custom = %q, want %q", h.Get("X-Custom"), "val")
+	}
+}
+
+func TestSendExpectUnexpectedStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	if _, err := req.SendExpect(200, 204); err == nil {
+		t.Error("expected an error for an unexpected status code")
+	}
+}
+
 func TestQueryParams(t *testing.T) {
 	params := map[string]string{
 		"foo": "bar",
@@ -167,6 +539,30 @@ func TestQueryParams(t *testing.T) {
 	}
 }
 
+func TestRequestAddQueryMultiValue(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()["tag"]
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.AddQuery("tag", "a")
+	req.AddQuery("tag", "b")
+
+	values := req.GetQueryValues("tag")
+	if len(values) != 2 || values[0] != "a" || values[1] != "b" {
+		t.Errorf("GetQueryValues = %v, want [a b]", values)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("server saw tag = %v, want [a b]", got)
+	}
+}
+
 func TestRequestCopy(t *testing.T) {
 	r1 := requests.Request{
 		Method: requests.GET,
@@ -213,6 +609,130 @@ func TestRequestPathParse(t *testing.T) {
 	}
 }
 
+func TestNewPutRequest(t *testing.T) {
+	req := requests.NewPutRequest("http://example.com", "application/json", []byte(`{"a":1}`))
+	if req.Method != requests.PUT {
+		t.Errorf("Method = %v, want PUT", req.Method)
+	}
+	if ct, _ := req.GetHeader("content-type"); ct != "application/json" {
+		t.Errorf("content-type = %q, want %q", ct, "application/json")
+	}
+	if string(req.Body) != `{"a":1}` {
+		t.Errorf("Body = %q, want %q", req.Body, `{"a":1}`)
+	}
+}
+
+func TestNewPatchRequest(t *testing.T) {
+	req := requests.NewPatchRequest("http://example.com", "application/json", []byte(`{"a":1}`))
+	if req.Method != requests.PATCH {
+		t.Errorf("Method = %v, want PATCH", req.Method)
+	}
+	if ct, _ := req.GetHeader("content-type"); ct != "application/json" {
+		t.Errorf("content-type = %q, want %q", ct, "application/json")
+	}
+	if string(req.Body) != `{"a":1}` {
+		t.Errorf("Body = %q, want %q", req.Body, `{"a":1}`)
+	}
+}
+
+func TestNewDeleteRequest(t *testing.T) {
+	req := requests.NewDeleteRequest("http://example.com")
+	if req.Method != requests.DELETE {
+		t.Errorf("Method = %v, want DELETE", req.Method)
+	}
+}
+
+func TestNewHeadRequest(t *testing.T) {
+	req := requests.NewHeadRequest("http://example.com")
+	if req.Method != requests.HEAD {
+		t.Errorf("Method = %v, want HEAD", req.Method)
+	}
+}
+
+func TestSendHeadRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("Request method is %q, not HEAD", r.Method)
+		}
+		w.Header().Set("Content-Length", "42")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	res, err := requests.SendHeadRequest(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", res.StatusCode)
+	}
+	if len(res.Body) != 0 {
+		t.Errorf("Body = %q, want empty", res.Body)
+	}
+	if cl, ok := res.GetHeader("content-length"); !ok || cl != "42" {
+		t.Errorf("content-length = %q, %v, want %q, true", cl, ok, "42")
+	}
+}
+
+func TestSendGetRequestHasNoBody(t *testing.T) {
+	var contentLength string
+	var hasBody bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.Header.Get("Content-Length")
+		hasBody = r.Body != nil && r.ContentLength != 0
+	}))
+	defer ts.Close()
+
+	if _, err := requests.SendGetRequest(ts.URL); err != nil {
+		t.Fatal(err)
+	}
+	if hasBody {
+		t.Error("expected GET request to have no body")
+	}
+	if contentLength != "" {
+		t.Errorf("Content-Length = %q, want unset", contentLength)
+	}
+}
+
+func TestSendPutRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Request method is %q not PUT", r.Method)
+		}
+	}))
+	defer ts.Close()
+
+	if _, err := requests.SendPutRequest(ts.URL, "application/json", []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendPatchRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("Request method is %q not PATCH", r.Method)
+		}
+	}))
+	defer ts.Close()
+
+	if _, err := requests.SendPatchRequest(ts.URL, "application/json", []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendDeleteRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Request method is %q not DELETE", r.Method)
+		}
+	}))
+	defer ts.Close()
+
+	if _, err := requests.SendDeleteRequest(ts.URL); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestSendPostRequest(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Make sure the request is a POST
@@ -367,6 +887,35 @@ func TestResponseJSON(t *testing.T) {
 	}
 }
 
+func TestResponseMustJSON(t *testing.T) {
+	defer func() {
+		if err := recover(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	resp := requests.Response{
+		Ok:         true,
+		StatusCode: 200,
+		Body:       []byte(`{"message":"pong"}`),
+	}
+	dat := resp.MustJSON()
+	if dat["message"] != "pong" {
+		t.Errorf("message = %v, want %q", dat["message"], "pong")
+	}
+}
+
+func TestResponseMustJSONPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustJSON should panic but doesn't")
+		}
+	}()
+
+	resp := requests.Response{Body: []byte(`not json`)}
+	_ = resp.MustJSON()
+}
+
 func BenchmarkResponseJSON(b *testing.B) {
 	resp := requests.Response{
 		Ok:         true,
@@ -0,0 +1,75 @@
+package requests_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendRetrySeeksSeekableBody(t *testing.T) {
+	var attempts int
+	var gotBodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		gotBodies = append(gotBodies, string(body))
+		if attempts < 2 {
+			hj, _ := w.(http.Hijacker)
+			conn, _, _ := hj.Hijack()
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	req := &requests.Request{
+		Method:       requests.POST,
+		URL:          ts.URL,
+		BodyReader:   bytes.NewReader([]byte("payload")),
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	}
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, b := range gotBodies {
+		if b != "payload" {
+			t.Errorf("body = %q, want %q", b, "payload")
+		}
+	}
+}
+
+func TestSendRetryNonSeekableBodyFails(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hj, _ := w.(http.Hijacker)
+		conn, _, _ := hj.Hijack()
+		conn.Close()
+	}))
+	defer ts.Close()
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("payload"))
+		pw.Close()
+	}()
+
+	req := &requests.Request{
+		Method:       requests.POST,
+		URL:          ts.URL,
+		BodyReader:   pr,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	}
+	_, err := req.Send()
+	if !errors.Is(err, requests.ErrBodyNotSeekable) {
+		t.Errorf("err = %v, want ErrBodyNotSeekable", err)
+	}
+}
@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryAfter parses the response's Retry-After header, supporting
+// both the delta-seconds form (e.g. "120") and the HTTP-date form
+// (e.g. "Wed, 21 Oct 2015 07:28:00 GMT"). It returns the duration to
+// wait and whether the header was present, so callers can implement
+// their own backoff using the server's hint even when they're not
+// using Send's automatic retries.
+func (res *Response) RetryAfter() (time.Duration, bool) {
+	v, ok := res.GetHeader("retry-after")
+	if !ok || v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+
+	d := time.Until(t)
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
@@ -0,0 +1,43 @@
+package requests_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseRetryAfterSeconds(t *testing.T) {
+	res := &requests.Response{
+		Headers: map[string]string{"retry-after": "120"},
+	}
+	d, ok := res.RetryAfter()
+	if !ok {
+		t.Fatal("expected Retry-After to be present")
+	}
+	if d != 120*time.Second {
+		t.Errorf("d = %v, want %v", d, 120*time.Second)
+	}
+}
+
+func TestResponseRetryAfterHTTPDate(t *testing.T) {
+	future := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	res := &requests.Response{
+		Headers: map[string]string{"retry-after": future},
+	}
+	d, ok := res.RetryAfter()
+	if !ok {
+		t.Fatal("expected Retry-After to be present")
+	}
+	if d <= 0 || d > time.Hour {
+		t.Errorf("d = %v, want roughly 1h", d)
+	}
+}
+
+func TestResponseRetryAfterMissing(t *testing.T) {
+	res := &requests.Response{}
+	if _, ok := res.RetryAfter(); ok {
+		t.Error("expected Retry-After to be absent")
+	}
+}
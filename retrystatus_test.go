@@ -0,0 +1,108 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendRetryRespectsRetryAfter(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	req := &requests.Request{
+		Method:       requests.GET,
+		URL:          ts.URL,
+		MaxRetries:   1,
+		RetryBackoff: time.Hour, // should be overridden by Retry-After: 0
+		RetryOnStatus: func(code int) bool {
+			return code == http.StatusServiceUnavailable
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		req.Send()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Send didn't return promptly; Retry-After: 0 wasn't respected over RetryBackoff")
+	}
+}
+
+func TestSendRetriesOnStatusCode(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	req := &requests.Request{
+		Method:       requests.GET,
+		URL:          ts.URL,
+		MaxRetries:   3,
+		RetryBackoff: time.Millisecond,
+		RetryOnStatus: func(code int) bool {
+			return code == http.StatusServiceUnavailable
+		},
+	}
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", res.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestSendRetriesExhaustedReturnsLastResponse(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	req := &requests.Request{
+		Method:       requests.GET,
+		URL:          ts.URL,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+		RetryOnStatus: func(code int) bool {
+			return code == http.StatusServiceUnavailable
+		},
+	}
+	res, err := req.Send()
+	if err == nil {
+		t.Fatal("expected an error once retries are exhausted")
+	}
+	if res == nil || res.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected the last 503 response to be returned alongside the error, got %v", res)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
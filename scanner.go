@@ -0,0 +1,58 @@
+package requests
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SendScanner sends the request and returns a *bufio.Scanner over the
+// streamed response body, for line-oriented responses (logs, SSE
+// without the event framing) that shouldn't be buffered in full.
+//
+// The returned closer must be called once the caller is done scanning
+// to release the underlying connection. ctx governs the request and is
+// honored for the lifetime of the scan - cancelling it unblocks a
+// pending Scan() with an error.
+//
+// SendScanner is a minimal, self-contained path like sendOverConn's
+// rather than sharing sendOnce's plumbing: it honors URL, Method,
+// Headers, HeaderValues, and Timeout, along with DefaultHeaders and
+// DefaultUserAgent. It does not consult Request.Client, TokenSource,
+// SignRequest, RateLimit, CompressBody, Breaker/MaxRetries/
+// RetryOnStatus, Proxy, or TLSConfig.
+func (req *Request) SendScanner(ctx context.Context) (scanner *bufio.Scanner, closer func() error, err error) {
+	client := http.Client{Timeout: req.Timeout}
+
+	u, err := req.getURL()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, req.Method.String(), u, req.getReqBody())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+	for k, v := range DefaultHeaders {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, v := range req.Headers {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, vs := range req.HeaderValues {
+		for _, v := range vs {
+			httpRequest.Header.Add(k, v)
+		}
+	}
+	if httpRequest.Header.Get("User-Agent") == "" {
+		httpRequest.Header.Set("User-Agent", DefaultUserAgent)
+	}
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, nil, wrapClientError(err)
+	}
+
+	return bufio.NewScanner(httpResponse.Body), httpResponse.Body.Close, nil
+}
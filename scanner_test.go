@@ -0,0 +1,109 @@
+package requests_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendScanner(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 1; i <= 3; i++ {
+			fmt.Fprintf(w, "line %d\n", i)
+		}
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	scanner, closer, err := req.SendScanner(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer()
+
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"line 1", "line 2", "line 3"}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d: %v", len(lines), len(want), lines)
+	}
+	for i, line := range lines {
+		if line != want[i] {
+			t.Errorf("line %d = %q, want %q", i, line, want[i])
+		}
+	}
+}
+
+func TestSendScannerContextCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "first line")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := requests.NewGetRequest(ts.URL)
+
+	scanner, closer, err := req.SendScanner(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer()
+
+	if !scanner.Scan() {
+		t.Fatal("expected to read the first line before cancellation")
+	}
+
+	cancel()
+	if scanner.Scan() {
+		t.Error("expected Scan to return false after context cancellation")
+	}
+}
+
+func TestSendScannerIgnoresTokenSourceSignRequestAndRateLimit(t *testing.T) {
+	var gotAuth, gotSig string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotSig = r.Header.Get("X-Signature")
+		fmt.Fprintln(w, "line 1")
+	}))
+	defer ts.Close()
+
+	limiter := &countingLimiter{}
+	req := requests.NewGetRequest(ts.URL)
+	req.TokenSource = staticTokenSource{token: "from-source"}
+	req.SignRequest = func(r *http.Request) error {
+		r.Header.Set("X-Signature", "signed")
+		return nil
+	}
+	req.RateLimit = limiter
+
+	scanner, closer, err := req.SendScanner(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer()
+	scanner.Scan()
+
+	if gotAuth != "" {
+		t.Errorf("Authorization = %q, want empty - SendScanner shouldn't consult TokenSource", gotAuth)
+	}
+	if gotSig != "" {
+		t.Errorf("X-Signature = %q, want empty - SendScanner shouldn't consult SignRequest", gotSig)
+	}
+	if atomic.LoadInt32(&limiter.calls) != 0 {
+		t.Errorf("limiter calls = %d, want 0 - SendScanner shouldn't consult RateLimit", limiter.calls)
+	}
+}
@@ -0,0 +1,32 @@
+package requests
+
+import "sync"
+
+// SendAll sends every request in reqs concurrently, bounded by a
+// worker pool of size concurrency (treated as 1 if less), and returns
+// their Results in the same order as reqs - so callers can fan out
+// many independent requests without reimplementing the goroutine/
+// semaphore bookkeeping themselves.
+func SendAll(reqs []*Request, concurrency int) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]Result, len(reqs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, req := range reqs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, req *Request) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res, err := req.Send()
+			results[i] = Result{Response: res, Err: err}
+		}(i, req)
+	}
+	wg.Wait()
+
+	return results
+}
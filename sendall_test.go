@@ -0,0 +1,64 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendAllPreservesOrder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Query().Get("i")))
+	}))
+	defer ts.Close()
+
+	var reqs []*requests.Request
+	for i := 0; i < 10; i++ {
+		req := requests.NewGetRequest(ts.URL)
+		req.SetQueryInt("i", i)
+		reqs = append(reqs, req)
+	}
+
+	results := requests.SendAll(reqs, 3)
+	if len(results) != 10 {
+		t.Fatalf("len(results) = %d, want 10", len(results))
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			t.Fatalf("result %d: unexpected error: %v", i, r.Err)
+		}
+		want := requests.URLEncode(i)
+		if string(r.Response.Body) != want {
+			t.Errorf("result %d body = %q, want %q", i, r.Response.Body, want)
+		}
+	}
+}
+
+func TestSendAllBoundsConcurrency(t *testing.T) {
+	var current, max int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		atomic.AddInt32(&current, -1)
+	}))
+	defer ts.Close()
+
+	var reqs []*requests.Request
+	for i := 0; i < 20; i++ {
+		reqs = append(reqs, requests.NewGetRequest(ts.URL))
+	}
+
+	requests.SendAll(reqs, 4)
+
+	if atomic.LoadInt32(&max) > 4 {
+		t.Errorf("max concurrent requests = %d, want <= 4", max)
+	}
+}
@@ -0,0 +1,104 @@
+package requests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+)
+
+// Session holds a cookie jar, base URL, and default headers shared
+// across a sequence of related requests - logging in and then
+// hitting authenticated endpoints, for example - so callers don't
+// have to thread cookies and common headers through every call by
+// hand.
+type Session struct {
+	Jar            http.CookieJar    // Cookies carried across requests
+	BaseURL        string            // Prepended to every path passed to Get/Post/etc.
+	DefaultHeaders map[string]string // Headers merged into every request
+}
+
+// NewSession creates a Session rooted at baseURL, with a fresh
+// in-memory cookie jar.
+func NewSession(baseURL string) (*Session, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("requests: failed to create cookie jar: %w", err)
+	}
+	return &Session{
+		Jar:            jar,
+		BaseURL:        baseURL,
+		DefaultHeaders: make(map[string]string),
+	}, nil
+}
+
+// SetDefaultHeader sets a header that will be merged into every
+// request sent through the Session, unless the request sets its own
+// value for the same (case-insensitive) key.
+func (s *Session) SetDefaultHeader(name, value string) {
+	if s.DefaultHeaders == nil {
+		s.DefaultHeaders = make(map[string]string)
+	}
+	s.DefaultHeaders[name] = value
+}
+
+// resolve joins path onto the Session's BaseURL.
+func (s *Session) resolve(path string) string {
+	return strings.TrimSuffix(s.BaseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// Do sends req through the Session: its URL is resolved against
+// BaseURL, the Session's default headers are merged in (the
+// request's own headers win on conflicts), and it shares the
+// Session's cookie jar with every other request sent through it.
+func (s *Session) Do(req *Request) (*Response, error) {
+	r := req.Copy()
+	r.URL = s.resolve(r.URL)
+
+	headers := make(map[string]string)
+	for k, v := range s.DefaultHeaders {
+		headers[strings.ToLower(k)] = v
+	}
+	for k, v := range req.Headers {
+		headers[strings.ToLower(k)] = v
+	}
+	r.Headers = headers
+
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	r.Client = &http.Client{Jar: s.Jar, Timeout: timeout}
+
+	return r.Send()
+}
+
+// Get sends an HTTP GET request to path, resolved against the
+// Session's BaseURL.
+func (s *Session) Get(path string) (*Response, error) {
+	return s.Do(NewGetRequest(path))
+}
+
+// Post sends an HTTP POST request to path, resolved against the
+// Session's BaseURL.
+func (s *Session) Post(path string, contentType string, body []byte) (*Response, error) {
+	return s.Do(NewPostRequest(path, contentType, body))
+}
+
+// Put sends an HTTP PUT request to path, resolved against the
+// Session's BaseURL.
+func (s *Session) Put(path string, contentType string, body []byte) (*Response, error) {
+	return s.Do(NewPutRequest(path, contentType, body))
+}
+
+// Patch sends an HTTP PATCH request to path, resolved against the
+// Session's BaseURL.
+func (s *Session) Patch(path string, contentType string, body []byte) (*Response, error) {
+	return s.Do(NewPatchRequest(path, contentType, body))
+}
+
+// Delete sends an HTTP DELETE request to path, resolved against the
+// Session's BaseURL.
+func (s *Session) Delete(path string) (*Response, error) {
+	return s.Do(NewDeleteRequest(path))
+}
@@ -0,0 +1,102 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSessionCarriesCookiesAcrossRequests(t *testing.T) {
+	var gotCookie string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc123"})
+		case "/me":
+			if c, err := r.Cookie("session"); err == nil {
+				gotCookie = c.Value
+			}
+		}
+	}))
+	defer ts.Close()
+
+	s, err := requests.NewSession(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Get("/login"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get("/me"); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotCookie != "abc123" {
+		t.Errorf("cookie seen on /me = %q, want %q", gotCookie, "abc123")
+	}
+}
+
+func TestSessionAppliesDefaultHeaders(t *testing.T) {
+	var gotAuth string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer ts.Close()
+
+	s, err := requests.NewSession(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetDefaultHeader("Authorization", "Bearer abc123")
+
+	if _, err := s.Get("/whoami"); err != nil {
+		t.Fatal(err)
+	}
+	if gotAuth != "Bearer abc123" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer abc123")
+	}
+}
+
+func TestSessionPost(t *testing.T) {
+	var gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make([]byte, r.ContentLength)
+		r.Body.Read(body)
+		gotBody = string(body)
+	}))
+	defer ts.Close()
+
+	s, err := requests.NewSession(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Post("/items", "application/json", []byte(`{"a":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if gotBody != `{"a":1}` {
+		t.Errorf("body = %q, want %q", gotBody, `{"a":1}`)
+	}
+}
+
+func TestSessionDoRespectsRequestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	s, err := requests.NewSession(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := requests.NewGetRequest("/slow")
+	req.Timeout = 20 * time.Millisecond
+	if _, err := s.Do(req); err == nil {
+		t.Error("expected a timeout error from Session.Do, got nil")
+	}
+}
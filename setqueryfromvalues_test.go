@@ -0,0 +1,40 @@
+package requests_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetQueryFromValues(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+
+	v := url.Values{}
+	v.Set("single", "one")
+	v.Add("multi", "a")
+	v.Add("multi", "b")
+	req.SetQueryFromValues(v)
+
+	if val, ok := req.GetQuery("single"); !ok || val != "one" {
+		t.Errorf("GetQuery(single) = (%q, %v), want (%q, true)", val, ok, "one")
+	}
+
+	got := req.GetQueryValues("multi")
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("GetQueryValues(multi) = %v, want [a b]", got)
+	}
+}
+
+func TestSetQueryFromValuesReplacesExisting(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetQuery("foo", "old")
+
+	v := url.Values{}
+	v.Set("foo", "new")
+	req.SetQueryFromValues(v)
+
+	if val, ok := req.GetQuery("foo"); !ok || val != "new" {
+		t.Errorf("GetQuery(foo) = (%q, %v), want (%q, true)", val, ok, "new")
+	}
+}
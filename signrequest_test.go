@@ -0,0 +1,48 @@
+package requests_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendCallsSignRequestBeforeSending(t *testing.T) {
+	var gotSignature string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get("X-Signature")
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewPostRequest(ts.URL, "application/json", []byte(`{"a":1}`))
+	req.SignRequest = func(r *http.Request) error {
+		r.Header.Set("X-Signature", r.Method+"-signed")
+		return nil
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "POST-signed"; gotSignature != want {
+		t.Errorf("X-Signature = %q, want %q", gotSignature, want)
+	}
+}
+
+func TestSendFailsWhenSignRequestErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SignRequest = func(r *http.Request) error {
+		return errors.New("signing failed")
+	}
+
+	if _, err := req.Send(); err == nil {
+		t.Fatal("expected an error when SignRequest errors")
+	}
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// SetBodyFromReader sets the request body to r, sniffing its content
+// type from the first 512 bytes (the same window http.DetectContentType
+// uses) and setting the content-type header from it, unless the
+// caller has already set one. The sniffed bytes aren't lost - they're
+// prepended back onto the stream via io.MultiReader - so this works
+// for uploads whose type isn't known ahead of time without buffering
+// the whole body into memory.
+func (req *Request) SetBodyFromReader(r io.Reader) error {
+	sniff := make([]byte, 512)
+	n, err := io.ReadFull(r, sniff)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return err
+	}
+	sniff = sniff[:n]
+
+	if _, ok := req.GetHeader("content-type"); !ok {
+		req.SetHeader("content-type", http.DetectContentType(sniff))
+	}
+
+	req.BodyReader = io.MultiReader(bytes.NewReader(sniff), r)
+	return nil
+}
@@ -0,0 +1,52 @@
+package requests_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetBodyFromReaderSniffsContentType(t *testing.T) {
+	var gotContentType string
+	var gotBody []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer ts.Close()
+
+	payload := []byte("<html><body>hi</body></html>")
+	req := requests.NewGetRequest(ts.URL)
+	req.Method = requests.POST
+	if err := req.SetBodyFromReader(bytes.NewReader(payload)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotContentType != "text/html; charset=utf-8" {
+		t.Errorf("content-type = %q, want %q", gotContentType, "text/html; charset=utf-8")
+	}
+	if !bytes.Equal(gotBody, payload) {
+		t.Errorf("body = %q, want %q", gotBody, payload)
+	}
+}
+
+func TestSetBodyFromReaderRespectsExplicitContentType(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetHeader("content-type", "application/custom")
+	if err := req.SetBodyFromReader(bytes.NewReader([]byte("data"))); err != nil {
+		t.Fatal(err)
+	}
+
+	ct, _ := req.GetHeader("content-type")
+	if ct != "application/custom" {
+		t.Errorf("content-type = %q, want %q", ct, "application/custom")
+	}
+}
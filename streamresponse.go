@@ -0,0 +1,130 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+)
+
+// SendStream sends the request and returns the response body as an
+// io.ReadCloser the caller reads and closes directly, instead of
+// buffering it into memory the way Send does. The returned Response
+// has its Headers, StatusCode, and other metadata populated as usual,
+// but Body is left nil - read it from the returned stream instead.
+// This is for multi-gigabyte downloads where a plain Send's
+// ioutil.ReadAll would exhaust memory; for everything else, Send's
+// in-memory Body is simpler to work with.
+//
+// SendStream builds its own *http.Request rather than sharing
+// sendOnce's plumbing, so it honors Request.Client (or else Timeout,
+// TLSConfig/Proxy via buildTransport, DisableRedirects/MaxRedirects),
+// Headers, HeaderValues, OnProgress, and OkFunc, along with
+// DefaultHeaders/DefaultUserAgent. It does not consult TokenSource,
+// SignRequest, RateLimit, CompressBody, or Breaker/MaxRetries/
+// RetryOnStatus - there's no retry loop at all, since the body is
+// streamed to the caller instead of buffered for a possible resend.
+func (req *Request) SendStream() (io.ReadCloser, *Response, error) {
+	client := req.Client
+	if client == nil {
+		client = &http.Client{
+			Timeout:   req.Timeout,
+			Transport: req.transport,
+		}
+		t, err := req.buildTransport()
+		if err != nil {
+			return nil, nil, err
+		}
+		if t != nil {
+			client.Transport = t
+		}
+		if req.DisableRedirects {
+			client.CheckRedirect = func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			}
+		} else if req.MaxRedirects > 0 {
+			maxRedirects := req.MaxRedirects
+			client.CheckRedirect = func(_ *http.Request, via []*http.Request) error {
+				if len(via) > maxRedirects {
+					return http.ErrUseLastResponse
+				}
+				return nil
+			}
+		}
+	}
+
+	u, err := req.getURL()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := req.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if req.trace != nil {
+		ctx = httptrace.WithClientTrace(ctx, req.trace)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, req.Method.String(), u, req.getReqBody())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+	for k, v := range DefaultHeaders {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, v := range req.Headers {
+		httpRequest.Header.Set(k, v)
+	}
+	for k, vs := range req.HeaderValues {
+		for _, v := range vs {
+			httpRequest.Header.Add(k, v)
+		}
+	}
+	if httpRequest.Header.Get("User-Agent") == "" {
+		httpRequest.Header.Set("User-Agent", DefaultUserAgent)
+	}
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, nil, wrapClientError(err)
+	}
+
+	rHeaders := make(map[string]string)
+	rHeaderValues := make(map[string][]string)
+	for k, v := range httpResponse.Header {
+		if len(v) > 0 {
+			lowerKey := strings.ToLower(k)
+			rHeaders[lowerKey] = v[0]
+			rHeaderValues[lowerKey] = v
+		}
+	}
+
+	ok := httpResponse.StatusCode < 400
+	if req.OkFunc != nil {
+		ok = req.OkFunc(httpResponse.StatusCode)
+	}
+	res := &Response{
+		Ok:           ok,
+		StatusCode:   httpResponse.StatusCode,
+		Headers:      rHeaders,
+		HeaderValues: rHeaderValues,
+		FinalURL:     httpResponse.Request.URL.String(),
+	}
+
+	var body io.ReadCloser = httpResponse.Body
+	if req.OnProgress != nil {
+		body = &progressReadCloser{
+			progressReader: &progressReader{
+				r:          httpResponse.Body,
+				total:      httpResponse.ContentLength,
+				onProgress: req.OnProgress,
+			},
+			closer: httpResponse.Body,
+		}
+	}
+
+	return body, res, nil
+}
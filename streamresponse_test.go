@@ -0,0 +1,79 @@
+package requests_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendStream(t *testing.T) {
+	payload := strings.Repeat("x", 1<<16)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Custom", "hi")
+		w.Write([]byte(payload))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	body, res, err := req.SendStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	if res.Body != nil {
+		t.Errorf("Response.Body = %v, want nil", res.Body)
+	}
+	if got, ok := res.GetHeader("x-custom"); !ok || got != "hi" {
+		t.Errorf("X-Custom header = %q, ok = %v, want %q", got, ok, "hi")
+	}
+
+	got, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != payload {
+		t.Errorf("streamed body length = %d, want %d", len(got), len(payload))
+	}
+}
+
+func TestSendStreamIgnoresTokenSourceSignRequestAndRateLimit(t *testing.T) {
+	var gotAuth, gotSig string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotSig = r.Header.Get("X-Signature")
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	limiter := &countingLimiter{}
+	req := requests.NewGetRequest(ts.URL)
+	req.TokenSource = staticTokenSource{token: "from-source"}
+	req.SignRequest = func(r *http.Request) error {
+		r.Header.Set("X-Signature", "signed")
+		return nil
+	}
+	req.RateLimit = limiter
+
+	body, _, err := req.SendStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	io.ReadAll(body)
+
+	if gotAuth != "" {
+		t.Errorf("Authorization = %q, want empty - SendStream shouldn't consult TokenSource", gotAuth)
+	}
+	if gotSig != "" {
+		t.Errorf("X-Signature = %q, want empty - SendStream shouldn't consult SignRequest", gotSig)
+	}
+	if atomic.LoadInt32(&limiter.calls) != 0 {
+		t.Errorf("limiter calls = %d, want 0 - SendStream shouldn't consult RateLimit", limiter.calls)
+	}
+}
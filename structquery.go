@@ -0,0 +1,122 @@
+package requests
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// SetQueryFromStruct populates the request's query parameters from the
+// fields of v, a struct (or pointer to struct). Fields are named by
+// their `query:"name,omitempty"` tag; the tag name defaults to the
+// field name if omitted, a tag of "-" skips the field, and the
+// "omitempty" option skips the field when it holds its zero value.
+// Slice fields are added as repeated params via QueryValues; all other
+// supported field types (string, bool, ints, uints, floats) are set
+// via SetQuery. It returns an error if v isn't a struct or contains a
+// field of an unsupported type.
+func (req *Request) SetQueryFromStruct(v interface{}) error {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return fmt.Errorf("requests: SetQueryFromStruct: nil pointer")
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("requests: SetQueryFromStruct: expected a struct, got %s", val.Kind())
+	}
+
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// Unexported field
+			continue
+		}
+
+		name, omitempty, skip := parseQueryTag(field)
+		if skip {
+			continue
+		}
+
+		fv := val.Field(i)
+		if omitempty && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array {
+			for j := 0; j < fv.Len(); j++ {
+				s, err := formatQueryValue(fv.Index(j))
+				if err != nil {
+					return fmt.Errorf("requests: SetQueryFromStruct: field %s: %w", field.Name, err)
+				}
+				req.AddQuery(name, s)
+			}
+			continue
+		}
+
+		s, err := formatQueryValue(fv)
+		if err != nil {
+			return fmt.Errorf("requests: SetQueryFromStruct: field %s: %w", field.Name, err)
+		}
+		req.SetQuery(name, s)
+	}
+
+	return nil
+}
+
+// AddQuery appends value to the set of query values for name,
+// allowing the same query parameter to be sent more than once (e.g.
+// ?tag=a&tag=b).
+func (req *Request) AddQuery(name, value string) {
+	if req.QueryValues == nil {
+		req.QueryValues = make(map[string][]string)
+	}
+	req.QueryValues[name] = append(req.QueryValues[name], value)
+}
+
+// parseQueryTag reads the `query` tag off of field, returning the
+// query parameter name to use, whether "omitempty" was set, and
+// whether the field should be skipped entirely (tag is "-").
+func parseQueryTag(field reflect.StructField) (name string, omitempty bool, skip bool) {
+	name = field.Name
+	tag, ok := field.Tag.Lookup("query")
+	if !ok {
+		return name, false, false
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] == "-" {
+		return name, false, true
+	}
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+// formatQueryValue formats a scalar reflect.Value as a query string
+// value.
+func formatQueryValue(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported query field type %s", v.Kind())
+	}
+}
@@ -0,0 +1,59 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetQueryFromStruct(t *testing.T) {
+	type opts struct {
+		Name    string   `query:"name"`
+		Page    int      `query:"page,omitempty"`
+		Active  bool     `query:"active,omitempty"`
+		Tags    []string `query:"tag,omitempty"`
+		Ignored string   `query:"-"`
+		private string
+	}
+
+	req := &requests.Request{}
+	err := req.SetQueryFromStruct(opts{
+		Name:    "alice",
+		Page:    0,
+		Active:  true,
+		Tags:    []string{"a", "b"},
+		Ignored: "nope",
+		private: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := req.GetQuery("name"); !ok || v != "alice" {
+		t.Errorf("name = %q, %v, want %q, true", v, ok, "alice")
+	}
+	if _, ok := req.GetQuery("page"); ok {
+		t.Error("page should have been omitted (zero value)")
+	}
+	if v, ok := req.GetQuery("active"); !ok || v != "true" {
+		t.Errorf("active = %q, %v, want %q, true", v, ok, "true")
+	}
+	if _, ok := req.GetQuery("-"); ok {
+		t.Error("Ignored field should not have produced a query param")
+	}
+	if v, ok := req.GetQuery("private"); ok {
+		t.Errorf("private = %q, want untouched (unexported)", v)
+	}
+
+	tags := req.QueryValues["tag"]
+	if len(tags) != 2 || tags[0] != "a" || tags[1] != "b" {
+		t.Errorf("tag = %v, want [a b]", tags)
+	}
+}
+
+func TestSetQueryFromStructNotStruct(t *testing.T) {
+	req := &requests.Request{}
+	if err := req.SetQueryFromStruct(42); err == nil {
+		t.Error("expected an error for a non-struct argument")
+	}
+}
@@ -0,0 +1,191 @@
+package requests_test
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+// newConnectProxy starts a minimal HTTP CONNECT proxy for testing
+// Request.Proxy against an httptest TLS server.
+func newConnectProxy(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "only CONNECT supported", http.StatusMethodNotAllowed)
+			return
+		}
+		destConn, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer destConn.Close()
+
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+			return
+		}
+		clientConn, _, err := hijacker.Hijack()
+		if err != nil {
+			return
+		}
+		defer clientConn.Close()
+		clientConn.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n"))
+
+		done := make(chan struct{})
+		go func() {
+			io.Copy(destConn, clientConn)
+			close(done)
+		}()
+		io.Copy(clientConn, destConn)
+		<-done
+	}))
+}
+
+// These tests hit a self-signed httptest TLS server, so every request
+// fails certificate verification - but the *stage* at which it fails
+// distinguishes a version-negotiation rejection (before cert checks)
+// from the usual untrusted-cert failure.
+
+func TestClientMinTLSVersionAllowsCompliantVersion(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.MinTLSVersion = tls.VersionTLS12
+
+	_, err := c.Do(requests.NewGetRequest(ts.URL))
+	if err == nil {
+		t.Fatal("expected a certificate trust error")
+	}
+	if strings.Contains(err.Error(), "protocol version") {
+		t.Errorf("expected a cert-trust failure, not a version rejection: %v", err)
+	}
+}
+
+func TestClientMinTLSVersionRejectsOldServer(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.TLS = &tls.Config{MaxVersion: tls.VersionTLS11}
+	ts.StartTLS()
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.MinTLSVersion = tls.VersionTLS12
+
+	_, err := c.Do(requests.NewGetRequest(ts.URL))
+	if err == nil {
+		t.Fatal("expected an error when the server can't negotiate MinTLSVersion")
+	}
+}
+
+func TestClientPinnedCertSHA256Matches(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	sum := sha256.Sum256(ts.Certificate().Raw)
+	pin := hex.EncodeToString(sum[:])
+
+	c := requests.NewClient()
+	c.PinnedCertSHA256 = []string{pin}
+
+	res, err := c.Do(requests.NewGetRequest(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "ok" {
+		t.Errorf("body = %q, want %q", res.Body, "ok")
+	}
+}
+
+func TestRequestTLSConfigInsecureSkipVerify(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.TLSConfig = &tls.Config{InsecureSkipVerify: true}
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "ok" {
+		t.Errorf("body = %q, want %q", res.Body, "ok")
+	}
+}
+
+func TestRequestTLSConfigCustomCA(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+
+	req := requests.NewGetRequest(ts.URL)
+	req.TLSConfig = &tls.Config{RootCAs: pool}
+
+	res, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Body) != "ok" {
+		t.Errorf("body = %q, want %q", res.Body, "ok")
+	}
+}
+
+func TestClientPinnedCertSurvivesRequestProxy(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	proxy := newConnectProxy(t)
+	defer proxy.Close()
+
+	sum := sha256.Sum256(ts.Certificate().Raw)
+	pin := hex.EncodeToString(sum[:])
+
+	c := requests.NewClient()
+	c.PinnedCertSHA256 = []string{pin}
+
+	req := requests.NewGetRequest(ts.URL)
+	req.Proxy = proxy.URL
+
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("pinning should have survived the per-request Proxy: %v", err)
+	}
+	if string(res.Body) != "ok" {
+		t.Errorf("body = %q, want %q", res.Body, "ok")
+	}
+}
+
+func TestClientPinnedCertSHA256Mismatch(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.PinnedCertSHA256 = []string{strings.Repeat("0", 64)}
+
+	_, err := c.Do(requests.NewGetRequest(ts.URL))
+	if !errors.Is(err, requests.ErrCertificatePinMismatch) {
+		t.Errorf("err = %v, want ErrCertificatePinMismatch", err)
+	}
+}
@@ -0,0 +1,77 @@
+package requests_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+type staticTokenSource struct {
+	token string
+}
+
+func (s staticTokenSource) Token() (string, error) {
+	return s.token, nil
+}
+
+func TestSendSetsAuthorizationFromTokenSource(t *testing.T) {
+	var gotAuth string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.TokenSource = staticTokenSource{token: "abc123"}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Bearer abc123"; gotAuth != want {
+		t.Errorf("Authorization = %q, want %q", gotAuth, want)
+	}
+}
+
+func TestSendTokenSourceDoesNotOverrideExplicitAuth(t *testing.T) {
+	var gotAuth string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetBearerToken("explicit-token")
+	req.TokenSource = staticTokenSource{token: "from-source"}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Bearer explicit-token"; gotAuth != want {
+		t.Errorf("Authorization = %q, want %q", gotAuth, want)
+	}
+}
+
+type erroringTokenSource struct{}
+
+func (erroringTokenSource) Token() (string, error) {
+	return "", errors.New("token refresh failed")
+}
+
+func TestSendFailsWhenTokenSourceErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.TokenSource = erroringTokenSource{}
+
+	if _, err := req.Send(); err == nil {
+		t.Fatal("expected an error when the token source errors")
+	}
+}
@@ -0,0 +1,31 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSetQueryInt(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetQueryInt("page", 3)
+	if v, ok := req.GetQuery("page"); !ok || v != "3" {
+		t.Errorf("GetQuery(page) = (%q, %v), want (%q, true)", v, ok, "3")
+	}
+}
+
+func TestSetQueryBool(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetQueryBool("verbose", true)
+	if v, ok := req.GetQuery("verbose"); !ok || v != "true" {
+		t.Errorf("GetQuery(verbose) = (%q, %v), want (%q, true)", v, ok, "true")
+	}
+}
+
+func TestSetQueryFloat(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.SetQueryFloat("ratio", 0.5)
+	if v, ok := req.GetQuery("ratio"); !ok || v != "0.5" {
+		t.Errorf("GetQuery(ratio) = (%q, %v), want (%q, true)", v, ok, "0.5")
+	}
+}
@@ -0,0 +1,51 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendPreservesExistingQueryParams(t *testing.T) {
+	var gotQuery url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL + "/path?foo=bar")
+	req.Query = map[string]string{"baz": "qux"}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotQuery.Get("foo") != "bar" {
+		t.Errorf("foo = %q, want %q", gotQuery.Get("foo"), "bar")
+	}
+	if gotQuery.Get("baz") != "qux" {
+		t.Errorf("baz = %q, want %q", gotQuery.Get("baz"), "qux")
+	}
+}
+
+func TestSendQueryWinsOverExistingParamOnConflict(t *testing.T) {
+	var gotQuery url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL + "/path?foo=old")
+	req.Query = map[string]string{"foo": "new"}
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if vs := gotQuery["foo"]; len(vs) != 1 || vs[0] != "new" {
+		t.Errorf("foo = %v, want [%q]", vs, "new")
+	}
+}
@@ -0,0 +1,7 @@
+package requests
+
+// DefaultUserAgent is sent as the User-Agent header for any request
+// that doesn't set its own, overriding Go's own
+// "Go-http-client/1.1" default, which some APIs block. Override this
+// package-level variable to change it for every request at once.
+var DefaultUserAgent = "go-requests/0.1.0"
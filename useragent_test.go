@@ -0,0 +1,67 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestSendUsesDefaultUserAgent(t *testing.T) {
+	var gotUserAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+	}))
+	defer ts.Close()
+
+	if _, err := requests.NewGetRequest(ts.URL).Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotUserAgent != requests.DefaultUserAgent {
+		t.Errorf("User-Agent = %q, want %q", gotUserAgent, requests.DefaultUserAgent)
+	}
+}
+
+func TestSendRespectsCustomUserAgent(t *testing.T) {
+	var gotUserAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+	}))
+	defer ts.Close()
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetHeader("User-Agent", "my-app/1.0")
+
+	if _, err := req.Send(); err != nil {
+		t.Fatal(err)
+	}
+	if gotUserAgent != "my-app/1.0" {
+		t.Errorf("User-Agent = %q, want %q", gotUserAgent, "my-app/1.0")
+	}
+}
+
+func TestRequestUserAgentOverridesClientDefault(t *testing.T) {
+	var gotUserAgents []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgents = r.Header.Values("User-Agent")
+	}))
+	defer ts.Close()
+
+	c := requests.NewClient()
+	c.SetDefaultHeader("User-Agent", "client-default/1.0")
+
+	req := requests.NewGetRequest(ts.URL)
+	req.SetHeader("User-Agent", "custom-agent/2.0")
+
+	if _, err := c.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(gotUserAgents) != 1 {
+		t.Fatalf("got %d User-Agent headers, want 1: %v", len(gotUserAgents), gotUserAgents)
+	}
+	if gotUserAgents[0] != "custom-agent/2.0" {
+		t.Errorf("User-Agent = %q, want %q", gotUserAgents[0], "custom-agent/2.0")
+	}
+}
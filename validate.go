@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Validate checks req for obvious misconfigurations before it's
+// built and sent: an empty or unparseable URL, a Method outside the
+// known HTTPMethod values, and a body set on a GET or HEAD request
+// (unusual, and often a sign the wrong field was populated). It
+// catches these as clear errors instead of letting them surface as
+// obscure transport failures or a server's confused response.
+func (req *Request) Validate() error {
+	if req.URL == "" {
+		return fmt.Errorf("requests: Validate: URL is required")
+	}
+	if _, err := url.Parse(req.URL); err != nil {
+		return fmt.Errorf("requests: Validate: invalid URL: %w", err)
+	}
+
+	switch req.Method {
+	case GET, POST, PUT, DELETE, OPTIONS, HEAD, CONNECT, TRACE, PATCH:
+	default:
+		return fmt.Errorf("requests: Validate: unknown HTTP method %v", req.Method)
+	}
+
+	if (req.Method == GET || req.Method == HEAD) && (len(req.Body) > 0 || req.BodyReader != nil) {
+		return fmt.Errorf("requests: Validate: %s requests shouldn't have a body", req.Method)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestValidateOK(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	if err := req.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateEmptyURL(t *testing.T) {
+	req := &requests.Request{Method: requests.GET}
+	if err := req.Validate(); err == nil {
+		t.Error("expected an error for an empty URL")
+	}
+}
+
+func TestValidateUnparseableURL(t *testing.T) {
+	req := requests.NewGetRequest("http://exa mple.com")
+	if err := req.Validate(); err == nil {
+		t.Error("expected an error for an unparseable URL")
+	}
+}
+
+func TestValidateUnknownMethod(t *testing.T) {
+	req := &requests.Request{URL: "http://example.com", Method: requests.HTTPMethod(99)}
+	if err := req.Validate(); err == nil {
+		t.Error("expected an error for an unknown method")
+	}
+}
+
+func TestValidateBodyOnGet(t *testing.T) {
+	req := requests.NewGetRequest("http://example.com")
+	req.Body = []byte("oops")
+	if err := req.Validate(); err == nil {
+		t.Error("expected an error for a body on a GET request")
+	}
+}
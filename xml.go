@@ -0,0 +1,10 @@
+package requests
+
+import "encoding/xml"
+
+// XML unmarshals the response body into v using encoding/xml,
+// mirroring JSONInto for APIs that respond with XML instead of JSON.
+// Decode errors are returned directly.
+func (res *Response) XML(v interface{}) error {
+	return xml.Unmarshal(res.Body, v)
+}
@@ -0,0 +1,41 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/a-poor/requests"
+)
+
+func TestResponseXML(t *testing.T) {
+	type item struct {
+		Name string `xml:"name"`
+	}
+	type catalog struct {
+		Items []item `xml:"item"`
+	}
+
+	res := &requests.Response{
+		Body: []byte(`<catalog><item><name>widget</name></item><item><name>gadget</name></item></catalog>`),
+	}
+
+	var c catalog
+	if err := res.XML(&c); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(c.Items))
+	}
+	if c.Items[0].Name != "widget" || c.Items[1].Name != "gadget" {
+		t.Errorf("Items = %+v, want widget, gadget", c.Items)
+	}
+}
+
+func TestResponseXMLError(t *testing.T) {
+	res := &requests.Response{
+		Body: []byte(`not xml`),
+	}
+	var v struct{}
+	if err := res.XML(&v); err == nil {
+		t.Fatal("expected an error for malformed XML")
+	}
+}